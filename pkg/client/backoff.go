@@ -0,0 +1,69 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultInitialDelay = 100 * time.Millisecond
+	defaultMaxDelay     = 30 * time.Second
+	defaultMultiplier   = 2
+)
+
+// Backoff specifies an exponential backoff, with optional random jitter, applied between attempts of an operation.
+// The zero value uses an initial delay of 100 milliseconds, doubling on each attempt up to a maximum of 30 seconds,
+// with no jitter.
+type Backoff struct {
+	// InitialDelay before the first retry attempt.
+	InitialDelay time.Duration
+	// MaxDelay is the upper limit for the delay between attempts.
+	MaxDelay time.Duration
+	// Multiplier applied to the delay after each attempt. A value of 1 gives a constant delay between attempts.
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, of each delay that is randomized.
+	Jitter float64
+}
+
+// delay before the specified retry attempt, where the first retry is attempt 1.
+func (backoff *Backoff) delay(attempt int) time.Duration {
+	initialDelay := backoff.InitialDelay
+	if initialDelay <= 0 {
+		initialDelay = defaultInitialDelay
+	}
+
+	maxDelay := backoff.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxDelay
+	}
+
+	multiplier := backoff.Multiplier
+	if multiplier <= 0 {
+		multiplier = defaultMultiplier
+	}
+
+	delay := math.Min(float64(initialDelay)*math.Pow(multiplier, float64(attempt-1)), float64(maxDelay))
+
+	jitter := math.Max(0, math.Min(backoff.Jitter, 1))
+	delay -= delay * jitter * rand.Float64() //#nosec G404 -- Jitter does not require a secure random source
+
+	return time.Duration(delay)
+}
+
+// sleep for the specified duration, returning early with an error if the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Run("Zero value uses defaults", func(t *testing.T) {
+		backoff := &Backoff{}
+
+		require.Equal(t, defaultInitialDelay, backoff.delay(1), "first attempt")
+		require.Equal(t, 2*defaultInitialDelay, backoff.delay(2), "second attempt")
+	})
+
+	t.Run("Delay increases by multiplier", func(t *testing.T) {
+		backoff := &Backoff{
+			InitialDelay: time.Second,
+			Multiplier:   3,
+		}
+
+		require.Equal(t, time.Second, backoff.delay(1), "first attempt")
+		require.Equal(t, 3*time.Second, backoff.delay(2), "second attempt")
+		require.Equal(t, 9*time.Second, backoff.delay(3), "third attempt")
+	})
+
+	t.Run("Delay does not exceed maximum", func(t *testing.T) {
+		backoff := &Backoff{
+			InitialDelay: time.Second,
+			MaxDelay:     5 * time.Second,
+		}
+
+		require.Equal(t, 5*time.Second, backoff.delay(10))
+	})
+
+	t.Run("Jitter reduces delay within bounds", func(t *testing.T) {
+		backoff := &Backoff{
+			InitialDelay: time.Second,
+			Jitter:       0.5,
+		}
+
+		for range 100 {
+			actual := backoff.delay(1)
+			require.GreaterOrEqual(t, actual, 500*time.Millisecond)
+			require.LessOrEqual(t, actual, time.Second)
+		}
+	})
+}
@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
@@ -14,9 +15,11 @@ import (
 )
 
 type baseBlockEventsRequest struct {
-	client    *gatewayClient
-	signingID *signingIdentity
-	request   *common.Envelope
+	client       *gatewayClient
+	signingID    *signingIdentity
+	request      *common.Envelope
+	builder      *baseBlockEventsBuilder
+	checkpointer Checkpointer
 }
 
 // Bytes of the serialized block events request.
@@ -58,6 +61,48 @@ func (events *baseBlockEventsRequest) setSignature(signature []byte) {
 	events.request.Signature = signature
 }
 
+func connectBlockEvents[T any](
+	ctx context.Context,
+	events *baseBlockEventsRequest,
+	operation Operation,
+	connectStream func(context.Context, *baseBlockEventsRequest) (eventReceiver[T], error),
+	blockNumber func(T) uint64,
+) (eventReceiver[T], error) {
+	if events.builder == nil {
+		return connectStream(ctx, events)
+	}
+
+	return connectWithReconnect(
+		ctx,
+		events.builder.reconnectPolicy,
+		func(ctx context.Context) (eventReceiver[T], error) {
+			return connectStream(ctx, events)
+		},
+		func(ctx context.Context, checkpoint Checkpoint) (eventReceiver[T], error) {
+			events.client.eventReconnecting(ctx, operation, events.builder.channelName)
+
+			request, err := events.builder.rebuild(checkpoint)
+			if err != nil {
+				return nil, err
+			}
+			return connectStream(ctx, request)
+		},
+		func(checkpointer *InMemoryCheckpointer, event T) error {
+			return checkpointer.CheckpointBlock(blockNumber(event))
+		},
+		func(checkpoint Checkpoint) bool {
+			endBlock := events.builder.endBlock
+			return endBlock != nil && checkpoint.BlockNumber() > *endBlock
+		},
+	)
+}
+
+func checkpointBlockEvents[T any](events iter.Seq2[T, error], checkpointer Checkpointer, blockNumber func(T) uint64) iter.Seq2[T, error] {
+	return checkpointEvents(events, checkpointer, func(checkpointer Checkpointer, event T) error {
+		return checkpointer.CheckpointBlock(blockNumber(event))
+	})
+}
+
 // FilteredBlockEventsRequest delivers filtered block events.
 type FilteredBlockEventsRequest struct {
 	baseBlockEventsRequest
@@ -65,31 +110,37 @@ type FilteredBlockEventsRequest struct {
 
 // Events returns a channel from which filtered block events can be read.
 func (events *FilteredBlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *peer.FilteredBlock, error) {
-	if err := events.sign(); err != nil {
-		return nil, err
-	}
-
-	eventsClient, err := events.client.FilteredBlockEvents(ctx, events.request, opts...)
-	if err != nil {
-		return nil, err
-	}
+	return sendEvents(ctx, func(ctx context.Context) (eventReceiver[*peer.FilteredBlock], error) {
+		return events.connect(ctx, opts...)
+	})
+}
 
-	results := make(chan *peer.FilteredBlock)
-	go func() {
-		defer close(results)
+// Iterate returns a sequence of filtered block events. The event stream is opened when iteration begins, and closed
+// when iteration stops. Iteration ends without error if the context is done. Any other failure to read events is
+// returned as the final error in the sequence, and is an [EventsError] if caused by a gRPC error. If a checkpointer was
+// specified using [WithCheckpointer], each event is checkpointed once it has been processed.
+func (events *FilteredBlockEventsRequest) Iterate(ctx context.Context, opts ...grpc.CallOption) iter.Seq2[*peer.FilteredBlock, error] {
+	results := iterateEvents(ctx, func(ctx context.Context) (eventReceiver[*peer.FilteredBlock], error) {
+		return events.connect(ctx, opts...)
+	})
+	return checkpointBlockEvents(results, events.checkpointer, (*peer.FilteredBlock).GetNumber)
+}
 
-		for {
-			response, err := eventsClient.Recv()
-			result := response.GetFilteredBlock()
-			if err != nil || result == nil {
-				return
-			}
+func (events *FilteredBlockEventsRequest) connect(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*peer.FilteredBlock], error) {
+	connectStream := func(ctx context.Context, request *baseBlockEventsRequest) (eventReceiver[*peer.FilteredBlock], error) {
+		if err := request.sign(); err != nil {
+			return nil, err
+		}
 
-			results <- result
+		eventsClient, err := request.client.FilteredBlockEvents(ctx, request.request, opts...)
+		if err != nil {
+			return nil, newEventsError(err)
 		}
-	}()
 
-	return results, nil
+		return newDeliverEventReceiver(eventsClient, (*peer.DeliverResponse).GetFilteredBlock), nil
+	}
+
+	return connectBlockEvents(ctx, &events.baseBlockEventsRequest, OperationFilteredBlockEvents, connectStream, (*peer.FilteredBlock).GetNumber)
 }
 
 // BlockEventsRequest delivers block events.
@@ -99,31 +150,41 @@ type BlockEventsRequest struct {
 
 // Events returns a channel from which block events can be read.
 func (events *BlockEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *common.Block, error) {
-	if err := events.sign(); err != nil {
-		return nil, err
-	}
-
-	eventsClient, err := events.client.BlockEvents(ctx, events.request, opts...)
-	if err != nil {
-		return nil, err
-	}
+	return sendEvents(ctx, func(ctx context.Context) (eventReceiver[*common.Block], error) {
+		return events.connect(ctx, opts...)
+	})
+}
 
-	results := make(chan *common.Block)
-	go func() {
-		defer close(results)
+// Iterate returns a sequence of block events. The event stream is opened when iteration begins, and closed when
+// iteration stops. Iteration ends without error if the context is done. Any other failure to read events is returned as
+// the final error in the sequence, and is an [EventsError] if caused by a gRPC error. If a checkpointer was specified
+// using [WithCheckpointer], each event is checkpointed once it has been processed.
+func (events *BlockEventsRequest) Iterate(ctx context.Context, opts ...grpc.CallOption) iter.Seq2[*common.Block, error] {
+	results := iterateEvents(ctx, func(ctx context.Context) (eventReceiver[*common.Block], error) {
+		return events.connect(ctx, opts...)
+	})
+	return checkpointBlockEvents(results, events.checkpointer, func(block *common.Block) uint64 {
+		return block.GetHeader().GetNumber()
+	})
+}
 
-		for {
-			response, err := eventsClient.Recv()
-			result := response.GetBlock()
-			if err != nil || result == nil {
-				return
-			}
+func (events *BlockEventsRequest) connect(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*common.Block], error) {
+	connectStream := func(ctx context.Context, request *baseBlockEventsRequest) (eventReceiver[*common.Block], error) {
+		if err := request.sign(); err != nil {
+			return nil, err
+		}
 
-			results <- result
+		eventsClient, err := request.client.BlockEvents(ctx, request.request, opts...)
+		if err != nil {
+			return nil, newEventsError(err)
 		}
-	}()
 
-	return results, nil
+		return newDeliverEventReceiver(eventsClient, (*peer.DeliverResponse).GetBlock), nil
+	}
+
+	return connectBlockEvents(ctx, &events.baseBlockEventsRequest, OperationBlockEvents, connectStream, func(block *common.Block) uint64 {
+		return block.GetHeader().GetNumber()
+	})
 }
 
 // BlockAndPrivateDataEventsRequest delivers block and private data events.
@@ -133,29 +194,39 @@ type BlockAndPrivateDataEventsRequest struct {
 
 // Events returns a channel from which block and private data events can be read.
 func (events *BlockAndPrivateDataEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *peer.BlockAndPrivateData, error) {
-	if err := events.sign(); err != nil {
-		return nil, err
-	}
-
-	eventsClient, err := events.client.BlockAndPrivateDataEvents(ctx, events.request, opts...)
-	if err != nil {
-		return nil, err
-	}
+	return sendEvents(ctx, func(ctx context.Context) (eventReceiver[*peer.BlockAndPrivateData], error) {
+		return events.connect(ctx, opts...)
+	})
+}
 
-	results := make(chan *peer.BlockAndPrivateData)
-	go func() {
-		defer close(results)
+// Iterate returns a sequence of block and private data events. The event stream is opened when iteration begins, and
+// closed when iteration stops. Iteration ends without error if the context is done. Any other failure to read events is
+// returned as the final error in the sequence, and is an [EventsError] if caused by a gRPC error. If a checkpointer was
+// specified using [WithCheckpointer], each event is checkpointed once it has been processed.
+func (events *BlockAndPrivateDataEventsRequest) Iterate(ctx context.Context, opts ...grpc.CallOption) iter.Seq2[*peer.BlockAndPrivateData, error] {
+	results := iterateEvents(ctx, func(ctx context.Context) (eventReceiver[*peer.BlockAndPrivateData], error) {
+		return events.connect(ctx, opts...)
+	})
+	return checkpointBlockEvents(results, events.checkpointer, func(event *peer.BlockAndPrivateData) uint64 {
+		return event.GetBlock().GetHeader().GetNumber()
+	})
+}
 
-		for {
-			response, err := eventsClient.Recv()
-			result := response.GetBlockAndPrivateData()
-			if err != nil || result == nil {
-				return
-			}
+func (events *BlockAndPrivateDataEventsRequest) connect(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*peer.BlockAndPrivateData], error) {
+	connectStream := func(ctx context.Context, request *baseBlockEventsRequest) (eventReceiver[*peer.BlockAndPrivateData], error) {
+		if err := request.sign(); err != nil {
+			return nil, err
+		}
 
-			results <- result
+		eventsClient, err := request.client.BlockAndPrivateDataEvents(ctx, request.request, opts...)
+		if err != nil {
+			return nil, newEventsError(err)
 		}
-	}()
 
-	return results, nil
+		return newDeliverEventReceiver(eventsClient, (*peer.DeliverResponse).GetBlockAndPrivateData), nil
+	}
+
+	return connectBlockEvents(ctx, &events.baseBlockEventsRequest, OperationBlockAndPrivateDataEvents, connectStream, func(event *peer.BlockAndPrivateData) uint64 {
+		return event.GetBlock().GetHeader().GetNumber()
+	})
 }
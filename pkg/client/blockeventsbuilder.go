@@ -13,10 +13,14 @@ import (
 )
 
 func seekLargestBlockNumber() *orderer.SeekPosition {
+	return seekBlockNumber(math.MaxUint64)
+}
+
+func seekBlockNumber(blockNumber uint64) *orderer.SeekPosition {
 	return &orderer.SeekPosition{
 		Type: &orderer.SeekPosition_Specified{
 			Specified: &orderer.SeekSpecified{
-				Number: math.MaxUint64,
+				Number: blockNumber,
 			},
 		},
 	}
@@ -82,30 +86,66 @@ func (builder *baseBlockEventsBuilder) signatureHeaderBytes() ([]byte, error) {
 func (builder *baseBlockEventsBuilder) dataBytes() ([]byte, error) {
 	data := &orderer.SeekInfo{
 		Start: builder.getStartPosition(),
-		Stop:  seekLargestBlockNumber(),
+		Stop:  builder.getStopPosition(),
 	}
 
 	return proto.Marshal(data)
 }
 
+func (builder *baseBlockEventsBuilder) getStopPosition() *orderer.SeekPosition {
+	if builder.endBlock != nil {
+		return seekBlockNumber(*builder.endBlock)
+	}
+
+	return seekLargestBlockNumber()
+}
+
+func (builder *baseBlockEventsBuilder) newRequest() (*baseBlockEventsRequest, error) {
+	if err := builder.validate(); err != nil {
+		return nil, err
+	}
+
+	payload, err := builder.payloadBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &baseBlockEventsRequest{
+		client:    builder.client,
+		signingID: builder.signingID,
+		request: &common.Envelope{
+			Payload: payload,
+		},
+		checkpointer: builder.checkpointer,
+	}
+	if builder.reconnectPolicy != nil {
+		result.builder = builder
+	}
+	return result, nil
+}
+
+// rebuild creates a new request that resumes eventing from the checkpoint position.
+func (builder *baseBlockEventsBuilder) rebuild(checkpoint Checkpoint) (*baseBlockEventsRequest, error) {
+	next := *builder
+	if err := WithCheckpoint(checkpoint)(&next.eventsBuilder); err != nil {
+		return nil, err
+	}
+
+	return next.newRequest()
+}
+
 type filteredBlockEventsBuilder struct {
 	baseBlockEventsBuilder
 }
 
 func (builder *filteredBlockEventsBuilder) build() (*FilteredBlockEventsRequest, error) {
-	payload, err := builder.payloadBytes()
+	request, err := builder.newRequest()
 	if err != nil {
 		return nil, err
 	}
 
 	result := &FilteredBlockEventsRequest{
-		baseBlockEventsRequest{
-			client:    builder.client,
-			signingID: builder.signingID,
-			request: &common.Envelope{
-				Payload: payload,
-			},
-		},
+		*request,
 	}
 	return result, nil
 }
@@ -115,19 +155,13 @@ type blockEventsBuilder struct {
 }
 
 func (builder *blockEventsBuilder) build() (*BlockEventsRequest, error) {
-	payload, err := builder.payloadBytes()
+	request, err := builder.newRequest()
 	if err != nil {
 		return nil, err
 	}
 
 	result := &BlockEventsRequest{
-		baseBlockEventsRequest{
-			client:    builder.client,
-			signingID: builder.signingID,
-			request: &common.Envelope{
-				Payload: payload,
-			},
-		},
+		*request,
 	}
 	return result, nil
 }
@@ -137,19 +171,13 @@ type blockAndPrivateDataEventsBuilder struct {
 }
 
 func (builder *blockAndPrivateDataEventsBuilder) build() (*BlockAndPrivateDataEventsRequest, error) {
-	payload, err := builder.payloadBytes()
+	request, err := builder.newRequest()
 	if err != nil {
 		return nil, err
 	}
 
 	result := &BlockAndPrivateDataEventsRequest{
-		baseBlockEventsRequest{
-			client:    builder.client,
-			signingID: builder.signingID,
-			request: &common.Envelope{
-				Payload: payload,
-			},
-		},
+		*request,
 	}
 	return result, nil
 }
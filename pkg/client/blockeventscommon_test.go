@@ -7,7 +7,9 @@ import (
 	"context"
 	"errors"
 	"io"
+	"iter"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer"
@@ -79,6 +81,74 @@ func TestCommonBlockEvents(t *testing.T) {
 					Stop: seekLargestBlockNumber(),
 				},
 			},
+			"Sends valid request with oldest start position": {
+				options: []BlockEventsOption{
+					WithStartOldestBlock(),
+				},
+				expected: &orderer.SeekInfo{
+					Start: &orderer.SeekPosition{
+						Type: &orderer.SeekPosition_Oldest{
+							Oldest: &orderer.SeekOldest{},
+						},
+					},
+					Stop: seekLargestBlockNumber(),
+				},
+			},
+			"Sends valid request with newest start position": {
+				options: []BlockEventsOption{
+					WithStartNewestBlock(),
+				},
+				expected: &orderer.SeekInfo{
+					Start: &orderer.SeekPosition{
+						Type: &orderer.SeekPosition_Newest{
+							Newest: &orderer.SeekNewest{},
+						},
+					},
+					Stop: seekLargestBlockNumber(),
+				},
+			},
+			"Uses checkpoint block instead of oldest start position": {
+				options: func() []BlockEventsOption {
+					checkpointer := new(InMemoryCheckpointer)
+					require.NoError(t, checkpointer.CheckpointBlock(500))
+					return []BlockEventsOption{
+						WithStartOldestBlock(),
+						WithCheckpoint(checkpointer),
+					}
+				}(),
+				expected: &orderer.SeekInfo{
+					Start: &orderer.SeekPosition{
+						Type: &orderer.SeekPosition_Specified{
+							Specified: &orderer.SeekSpecified{
+								Number: 501,
+							},
+						},
+					},
+					Stop: seekLargestBlockNumber(),
+				},
+			},
+			"Sends valid request with specified end block number": {
+				options: []BlockEventsOption{
+					WithStartBlock(100),
+					WithEndBlock(200),
+				},
+				expected: &orderer.SeekInfo{
+					Start: &orderer.SeekPosition{
+						Type: &orderer.SeekPosition_Specified{
+							Specified: &orderer.SeekSpecified{
+								Number: 100,
+							},
+						},
+					},
+					Stop: &orderer.SeekPosition{
+						Type: &orderer.SeekPosition_Specified{
+							Specified: &orderer.SeekSpecified{
+								Number: 200,
+							},
+						},
+					},
+				},
+			},
 			"Uses specified start block instead of unset checkpoint": {
 				options: []BlockEventsOption{
 					WithStartBlock(418),
@@ -98,7 +168,7 @@ func TestCommonBlockEvents(t *testing.T) {
 			"Uses checkpoint block instead of specified start block": {
 				options: func() []BlockEventsOption {
 					checkpointer := new(InMemoryCheckpointer)
-					checkpointer.CheckpointBlock(500)
+					require.NoError(t, checkpointer.CheckpointBlock(500))
 					return []BlockEventsOption{
 						WithStartBlock(418),
 						WithCheckpoint(checkpointer),
@@ -118,7 +188,7 @@ func TestCommonBlockEvents(t *testing.T) {
 			"Uses checkpoint block zero with set transaction ID instead of specified start block": {
 				options: func() []BlockEventsOption {
 					checkpointer := new(InMemoryCheckpointer)
-					checkpointer.CheckpointTransaction(0, "transctionId")
+					require.NoError(t, checkpointer.CheckpointTransaction(0, "transctionId"))
 					return []BlockEventsOption{
 						WithStartBlock(418),
 						WithCheckpoint(checkpointer),
@@ -186,6 +256,81 @@ func TestCommonBlockEvents(t *testing.T) {
 			require.False(t, ok, "Expected event listening to be cancelled, got %v", actual)
 		})
 
+		t.Run("Iterate returns connect error", func(t *testing.T) {
+			expected := NewStatusError(t, codes.Aborted, "BLOCK_EVENTS_ERROR")
+
+			tester := newTester(t)
+			tester.SetConnectError(expected)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var actualErr error
+			for _, err := range tester.Iterate(ctx) {
+				actualErr = err
+			}
+
+			var eventsErr *EventsError
+			require.ErrorAs(t, actualErr, &eventsErr)
+			require.Equal(t, status.Code(expected), status.Code(actualErr), "status code")
+			require.ErrorIs(t, actualErr, expected, "error type: %T", actualErr)
+		})
+
+		t.Run("Iterate returns receive error", func(t *testing.T) {
+			expected := NewStatusError(t, codes.Unavailable, "BLOCK_EVENTS_ERROR")
+
+			tester := newTester(t)
+			tester.SetReceiveError(expected)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var actualErr error
+			for _, err := range tester.Iterate(ctx) {
+				actualErr = err
+			}
+
+			var eventsErr *EventsError
+			require.ErrorAs(t, actualErr, &eventsErr)
+			require.Equal(t, status.Code(expected), status.Code(actualErr), "status code")
+			require.ErrorContains(t, actualErr, expected.Error(), "message")
+		})
+
+		t.Run("Iterate returns error on unsuccessful deliver status", func(t *testing.T) {
+			tester := newTester(t)
+			tester.SetResponses(&peer.DeliverResponse{
+				Type: &peer.DeliverResponse_Status{
+					Status: common.Status_FORBIDDEN,
+				},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var actualErr error
+			for _, err := range tester.Iterate(ctx) {
+				actualErr = err
+			}
+
+			require.ErrorContains(t, actualErr, common.Status_FORBIDDEN.String())
+		})
+
+		t.Run("Iterate ends without error on successful deliver status", func(t *testing.T) {
+			tester := newTester(t)
+			tester.SetResponses(&peer.DeliverResponse{
+				Type: &peer.DeliverResponse_Status{
+					Status: common.Status_SUCCESS,
+				},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			for event, err := range tester.Iterate(ctx) {
+				require.FailNow(t, "Unexpected iteration", "event: %v, err: %v", event, err)
+			}
+		})
+
 		t.Run("Uses specified gRPC call options", func(t *testing.T) {
 			expected := grpc.WaitForReady(true)
 
@@ -222,6 +367,355 @@ func TestCommonBlockEvents(t *testing.T) {
 	}
 }
 
+type blockEventsStreamTestCase struct {
+	expectDeliver func(*MockClientConnInterface, ...newStreamFunction) *MockClientConnInterface_NewStream_Call
+	newResponse   func(blockNumber uint64) *peer.DeliverResponse
+	iterate       func(*testing.T, context.Context, *Network, ...BlockEventsOption) iter.Seq2[proto.Message, error]
+}
+
+func newBlockEventsStreamTestCases() map[string]blockEventsStreamTestCase {
+	return map[string]blockEventsStreamTestCase{
+		"Block": {
+			expectDeliver: ExpectDeliver,
+			newResponse: func(blockNumber uint64) *peer.DeliverResponse {
+				return &peer.DeliverResponse{
+					Type: &peer.DeliverResponse_Block{
+						Block: &common.Block{
+							Header: &common.BlockHeader{
+								Number: blockNumber,
+							},
+						},
+					},
+				}
+			},
+			iterate: func(t *testing.T, ctx context.Context, network *Network, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+				request, err := network.NewBlockEventsRequest(options...)
+				require.NoError(t, err, "NewBlockEventsRequest")
+				return asMessageSeq(request.Iterate(ctx))
+			},
+		},
+		"FilteredBlock": {
+			expectDeliver: ExpectDeliverFiltered,
+			newResponse: func(blockNumber uint64) *peer.DeliverResponse {
+				return &peer.DeliverResponse{
+					Type: &peer.DeliverResponse_FilteredBlock{
+						FilteredBlock: &peer.FilteredBlock{
+							Number: blockNumber,
+						},
+					},
+				}
+			},
+			iterate: func(t *testing.T, ctx context.Context, network *Network, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+				request, err := network.NewFilteredBlockEventsRequest(options...)
+				require.NoError(t, err, "NewFilteredBlockEventsRequest")
+				return asMessageSeq(request.Iterate(ctx))
+			},
+		},
+		"BlockAndPrivateData": {
+			expectDeliver: ExpectDeliverWithPrivateData,
+			newResponse: func(blockNumber uint64) *peer.DeliverResponse {
+				return &peer.DeliverResponse{
+					Type: &peer.DeliverResponse_BlockAndPrivateData{
+						BlockAndPrivateData: &peer.BlockAndPrivateData{
+							Block: &common.Block{
+								Header: &common.BlockHeader{
+									Number: blockNumber,
+								},
+							},
+						},
+					},
+				}
+			},
+			iterate: func(t *testing.T, ctx context.Context, network *Network, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+				request, err := network.NewBlockAndPrivateDataEventsRequest(options...)
+				require.NoError(t, err, "NewBlockAndPrivateDataEventsRequest")
+				return asMessageSeq(request.Iterate(ctx))
+			},
+		},
+	}
+}
+
+func newMockDeliverStream(t *testing.T, requests chan<- *common.Envelope, finalErr error, responses ...*peer.DeliverResponse) *MockClientStream {
+	mockStream := NewMockClientStream(t)
+	ExpectSendMsg(mockStream, CaptureSendMsg(requests))
+	mockStream.EXPECT().CloseSend().Maybe().Return(nil)
+	ExpectRecvMsg(mockStream, func(message any) error {
+		if len(responses) == 0 {
+			return finalErr
+		}
+
+		proto.Merge(message.(proto.Message), responses[0])
+		responses = responses[1:]
+		return nil
+	})
+	return mockStream
+}
+
+func TestBlockEventsEndBlock(t *testing.T) {
+	for eventType, newRequest := range map[string]func(*Network, ...BlockEventsOption) error{
+		"Block": func(network *Network, options ...BlockEventsOption) error {
+			_, err := network.NewBlockEventsRequest(options...)
+			return err
+		},
+		"FilteredBlock": func(network *Network, options ...BlockEventsOption) error {
+			_, err := network.NewFilteredBlockEventsRequest(options...)
+			return err
+		},
+		"BlockAndPrivateData": func(network *Network, options ...BlockEventsOption) error {
+			_, err := network.NewBlockAndPrivateDataEventsRequest(options...)
+			return err
+		},
+	} {
+		t.Run(eventType, func(t *testing.T) {
+			t.Run("Returns error if end block is before start block", func(t *testing.T) {
+				network := AssertNewTestNetwork(t, "NETWORK")
+
+				err := newRequest(network, WithStartBlock(200), WithEndBlock(100))
+
+				require.ErrorContains(t, err, "end block")
+			})
+
+			t.Run("Allows end block equal to start block", func(t *testing.T) {
+				network := AssertNewTestNetwork(t, "NETWORK")
+
+				err := newRequest(network, WithStartBlock(100), WithEndBlock(100))
+
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestBlockEventsReconnect(t *testing.T) {
+	for eventType, testCase := range newBlockEventsStreamTestCases() {
+		t.Run(eventType, func(t *testing.T) {
+			unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+			successResponse := &peer.DeliverResponse{
+				Type: &peer.DeliverResponse_Status{
+					Status: common.Status_SUCCESS,
+				},
+			}
+
+			startBlock := func(t *testing.T, request *common.Envelope) uint64 {
+				payload := &common.Payload{}
+				AssertUnmarshal(t, request.GetPayload(), payload)
+				seekInfo := &orderer.SeekInfo{}
+				AssertUnmarshal(t, payload.GetData(), seekInfo)
+				return seekInfo.GetStart().GetSpecified().GetNumber()
+			}
+
+			t.Run("Resumes from next block following transport failure", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 2)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, unavailableErr, testCase.newResponse(5)),
+				)).Once()
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, nil, testCase.newResponse(6), successResponse),
+				)).Once()
+
+				var reconnects []error
+				policy := ReconnectPolicy{
+					Backoff: Backoff{
+						InitialDelay: time.Millisecond,
+					},
+					OnReconnect: func(attempt int, cause error) {
+						require.Equal(t, len(reconnects)+1, attempt, "attempt")
+						reconnects = append(reconnects, cause)
+					},
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+
+				var actual []proto.Message
+				for event, err := range testCase.iterate(t, ctx, network, WithStartBlock(5), WithReconnect(policy)) {
+					require.NoError(t, err)
+					actual = append(actual, event)
+				}
+
+				require.Len(t, actual, 2, "events")
+				require.Len(t, reconnects, 1, "reconnect notifications")
+				require.ErrorIs(t, reconnects[0], unavailableErr, "reconnect cause")
+				require.Equal(t, uint64(5), startBlock(t, <-requests), "first start block")
+				require.Equal(t, uint64(6), startBlock(t, <-requests), "second start block")
+			})
+
+			t.Run("Does not reconnect once end block is received", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 1)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, unavailableErr, testCase.newResponse(5)),
+				)).Once()
+
+				var reconnects []int
+				policy := ReconnectPolicy{
+					Backoff: Backoff{
+						InitialDelay: time.Millisecond,
+					},
+					OnReconnect: func(attempt int, _ error) {
+						reconnects = append(reconnects, attempt)
+					},
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+
+				var actual []proto.Message
+				for event, err := range testCase.iterate(t, ctx, network, WithStartBlock(5), WithEndBlock(5), WithReconnect(policy)) {
+					require.NoError(t, err)
+					actual = append(actual, event)
+				}
+
+				require.Len(t, actual, 1, "events")
+				require.Empty(t, reconnects, "reconnect notifications")
+			})
+
+			t.Run("Returns error after maximum reconnect attempts", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 2)
+				mockConnection := NewMockClientConnInterface(t)
+				for range 2 {
+					testCase.expectDeliver(mockConnection, WithNewStreamResult(newMockDeliverStream(t, requests, unavailableErr))).Once()
+				}
+
+				policy := ReconnectPolicy{
+					MaxAttempts: 1,
+					Backoff: Backoff{
+						InitialDelay: time.Millisecond,
+					},
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+
+				var actualErr error
+				for _, err := range testCase.iterate(t, ctx, network, WithReconnect(policy)) {
+					actualErr = err
+				}
+
+				var eventsErr *EventsError
+				require.ErrorAs(t, actualErr, &eventsErr)
+				require.Equal(t, codes.Unavailable, status.Code(actualErr), "status code")
+			})
+		})
+	}
+}
+
+func TestBlockEventsCheckpointer(t *testing.T) {
+	for eventType, testCase := range newBlockEventsStreamTestCases() {
+		t.Run(eventType, func(t *testing.T) {
+			successResponse := &peer.DeliverResponse{
+				Type: &peer.DeliverResponse_Status{
+					Status: common.Status_SUCCESS,
+				},
+			}
+
+			t.Run("Starts from checkpoint position", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 1)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(newMockDeliverStream(t, requests, nil, successResponse)))
+
+				checkpointer := new(InMemoryCheckpointer)
+				require.NoError(t, checkpointer.CheckpointBlock(4))
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+				for _, err := range testCase.iterate(t, ctx, network, WithStartBlock(1), WithCheckpointer(checkpointer)) {
+					require.NoError(t, err)
+				}
+
+				payload := &common.Payload{}
+				AssertUnmarshal(t, (<-requests).GetPayload(), payload)
+				seekInfo := &orderer.SeekInfo{}
+				AssertUnmarshal(t, payload.GetData(), seekInfo)
+				require.Equal(t, uint64(5), seekInfo.GetStart().GetSpecified().GetNumber())
+			})
+
+			t.Run("Checkpoints processed events", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 1)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, nil, testCase.newResponse(5), testCase.newResponse(6), successResponse),
+				))
+
+				checkpointer := new(InMemoryCheckpointer)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+				for _, err := range testCase.iterate(t, ctx, network, WithCheckpointer(checkpointer)) {
+					require.NoError(t, err)
+				}
+
+				require.Equal(t, uint64(7), checkpointer.BlockNumber(), "BlockNumber")
+				require.Empty(t, checkpointer.TransactionID(), "TransactionID")
+			})
+
+			t.Run("Does not checkpoint event when iteration stops", func(t *testing.T) {
+				requests := make(chan *common.Envelope, 1)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, nil, testCase.newResponse(5), testCase.newResponse(6)),
+				))
+
+				checkpointer := new(InMemoryCheckpointer)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+				var events []proto.Message
+				for event, err := range testCase.iterate(t, ctx, network, WithCheckpointer(checkpointer)) {
+					require.NoError(t, err)
+					events = append(events, event)
+					if len(events) == 2 {
+						break
+					}
+				}
+
+				require.Equal(t, uint64(6), checkpointer.BlockNumber(), "BlockNumber")
+			})
+
+			t.Run("Returns checkpoint error", func(t *testing.T) {
+				expected := errors.New("CHECKPOINT_ERROR")
+
+				requests := make(chan *common.Envelope, 1)
+				mockConnection := NewMockClientConnInterface(t)
+				testCase.expectDeliver(mockConnection, WithNewStreamResult(
+					newMockDeliverStream(t, requests, nil, testCase.newResponse(5), testCase.newResponse(6)),
+				))
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+
+				var events []proto.Message
+				var actualErr error
+				for event, err := range testCase.iterate(t, ctx, network, WithCheckpointer(&failingCheckpointer{err: expected})) {
+					if err != nil {
+						actualErr = err
+						continue
+					}
+					events = append(events, event)
+				}
+
+				require.Len(t, events, 1, "events")
+				require.ErrorIs(t, actualErr, expected)
+			})
+		})
+	}
+}
+
 type blockEventsTester interface {
 	SetConnectError(error)
 	SetNetworkName(string)
@@ -233,6 +727,7 @@ type blockEventsTester interface {
 	EventsWithCallOptions(context.Context, ...grpc.CallOption) error
 	CallOptions() <-chan []grpc.CallOption
 	Receive() (proto.Message, bool)
+	Iterate(context.Context, ...BlockEventsOption) iter.Seq2[proto.Message, error]
 }
 
 type baseBlockEventsTest struct {
@@ -299,6 +794,16 @@ func (b *baseBlockEventsTest) deliverOptions() []newStreamFunction {
 	return result
 }
 
+func asMessageSeq[T proto.Message](events iter.Seq2[T, error]) iter.Seq2[proto.Message, error] {
+	return func(yield func(proto.Message, error) bool) {
+		for event, err := range events {
+			if !yield(event, err) {
+				return
+			}
+		}
+	}
+}
+
 func (b *baseBlockEventsTest) newNetwork(connection grpc.ClientConnInterface) *Network {
 	options := []ConnectOption{
 		WithClientConnection(connection),
@@ -347,6 +852,17 @@ func (b *blockEventsTest) EventsWithCallOptions(ctx context.Context, options ...
 	return err
 }
 
+func (b *blockEventsTest) Iterate(ctx context.Context, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+	mockConnection := NewMockClientConnInterface(b.t)
+	ExpectDeliver(mockConnection, b.deliverOptions()...)
+
+	network := b.newNetwork(mockConnection)
+	request, err := network.NewBlockEventsRequest(options...)
+	require.NoError(b.t, err, "NewBlockEventsRequest")
+
+	return asMessageSeq(request.Iterate(ctx))
+}
+
 func (b *blockEventsTest) Receive() (proto.Message, bool) {
 	event, ok := <-b.BlockEvents
 	return event, ok
@@ -392,6 +908,17 @@ func (b *filteredBlockEventsTest) EventsWithCallOptions(ctx context.Context, opt
 	return err
 }
 
+func (b *filteredBlockEventsTest) Iterate(ctx context.Context, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+	mockConnection := NewMockClientConnInterface(b.t)
+	ExpectDeliverFiltered(mockConnection, b.deliverOptions()...)
+
+	network := b.newNetwork(mockConnection)
+	request, err := network.NewFilteredBlockEventsRequest(options...)
+	require.NoError(b.t, err, "NewFilteredBlockEventsRequest")
+
+	return asMessageSeq(request.Iterate(ctx))
+}
+
 func (b *filteredBlockEventsTest) Receive() (proto.Message, bool) {
 	event, ok := <-b.FilteredBlockEvents
 	return event, ok
@@ -437,6 +964,17 @@ func (b *blockAndPrivateDataEventsTest) EventsWithCallOptions(ctx context.Contex
 	return err
 }
 
+func (b *blockAndPrivateDataEventsTest) Iterate(ctx context.Context, options ...BlockEventsOption) iter.Seq2[proto.Message, error] {
+	mockConnection := NewMockClientConnInterface(b.t)
+	ExpectDeliverWithPrivateData(mockConnection, b.deliverOptions()...)
+
+	network := b.newNetwork(mockConnection)
+	request, err := network.NewBlockAndPrivateDataEventsRequest(options...)
+	require.NoError(b.t, err, "NewBlockAndPrivateDataEventsRequest")
+
+	return asMessageSeq(request.Iterate(ctx))
+}
+
 func (b *blockAndPrivateDataEventsTest) Receive() (proto.Message, bool) {
 	event, ok := <-b.BlocksAndPrivateData
 	return event, ok
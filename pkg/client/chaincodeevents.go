@@ -5,7 +5,10 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"iter"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"google.golang.org/grpc"
@@ -17,6 +20,9 @@ type ChaincodeEventsRequest struct {
 	client        *gatewayClient
 	signingID     *signingIdentity
 	signedRequest *gateway.SignedChaincodeEventsRequest
+	builder       *chaincodeEventsBuilder
+	checkpointer  Checkpointer
+	endBlock      *uint64
 }
 
 // Bytes of the serialized chaincode events request.
@@ -36,30 +42,80 @@ func (events *ChaincodeEventsRequest) Digest() []byte {
 
 // Events returns a channel from which chaincode events can be read.
 func (events *ChaincodeEventsRequest) Events(ctx context.Context, opts ...grpc.CallOption) (<-chan *ChaincodeEvent, error) {
+	return sendEvents(ctx, func(ctx context.Context) (eventReceiver[*ChaincodeEvent], error) {
+		return events.connect(ctx, opts...)
+	})
+}
+
+// Iterate returns a sequence of chaincode events. The event stream is opened when iteration begins, and closed when
+// iteration stops. Iteration ends without error if the context is done. Any other failure to read events is returned
+// as the final error in the sequence, and is an [EventsError] if caused by a gRPC error. If a checkpointer was
+// specified using [WithCheckpointer], each event is checkpointed once it has been processed.
+func (events *ChaincodeEventsRequest) Iterate(ctx context.Context, opts ...grpc.CallOption) iter.Seq2[*ChaincodeEvent, error] {
+	results := iterateEvents(ctx, func(ctx context.Context) (eventReceiver[*ChaincodeEvent], error) {
+		return events.connect(ctx, opts...)
+	})
+	return checkpointEvents(results, events.checkpointer, Checkpointer.CheckpointChaincodeEvent)
+}
+
+func (events *ChaincodeEventsRequest) connect(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*ChaincodeEvent], error) {
+	receive, err := events.connectWithReconnect(ctx, opts...)
+	if err != nil || events.endBlock == nil {
+		return receive, err
+	}
+
+	return receiveUntilBlock(receive, *events.endBlock, func(event *ChaincodeEvent) uint64 {
+		return event.BlockNumber
+	}), nil
+}
+
+func (events *ChaincodeEventsRequest) connectWithReconnect(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*ChaincodeEvent], error) {
+	if events.builder == nil {
+		return events.connectStream(ctx, opts...)
+	}
+
+	return connectWithReconnect(
+		ctx,
+		events.builder.reconnectPolicy,
+		func(ctx context.Context) (eventReceiver[*ChaincodeEvent], error) {
+			return events.connectStream(ctx, opts...)
+		},
+		func(ctx context.Context, checkpoint Checkpoint) (eventReceiver[*ChaincodeEvent], error) {
+			events.client.eventReconnecting(ctx, OperationChaincodeEvents, events.builder.channelName)
+
+			request, err := events.builder.rebuild(checkpoint)
+			if err != nil {
+				return nil, err
+			}
+			return request.connectStream(ctx, opts...)
+		},
+		(*InMemoryCheckpointer).CheckpointChaincodeEvent,
+		nil,
+	)
+}
+
+func (events *ChaincodeEventsRequest) connectStream(ctx context.Context, opts ...grpc.CallOption) (eventReceiver[*ChaincodeEvent], error) {
 	if err := events.sign(); err != nil {
 		return nil, err
 	}
 
 	eventsClient, err := events.client.ChaincodeEvents(ctx, events.signedRequest, opts...)
 	if err != nil {
-		return nil, err
+		return nil, newEventsError(err)
 	}
 
-	results := make(chan *ChaincodeEvent)
-	go func() {
-		defer close(results)
-
-		for {
-			response, err := eventsClient.Recv()
-			if err != nil {
-				return
+	receive := func() ([]*ChaincodeEvent, error) {
+		response, err := eventsClient.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, err
 			}
-
-			deliverChaincodeEvents(response, results)
+			return nil, newEventsError(err)
 		}
-	}()
 
-	return results, nil
+		return newChaincodeEvents(response), nil
+	}
+	return receive, nil
 }
 
 func (events *ChaincodeEventsRequest) sign() error {
@@ -95,14 +151,17 @@ type ChaincodeEvent struct {
 	Payload       []byte
 }
 
-func deliverChaincodeEvents(response *gateway.ChaincodeEventsResponse, send chan<- *ChaincodeEvent) {
+func newChaincodeEvents(response *gateway.ChaincodeEventsResponse) []*ChaincodeEvent {
+	results := make([]*ChaincodeEvent, 0, len(response.GetEvents()))
 	for _, event := range response.GetEvents() {
-		send <- &ChaincodeEvent{
+		results = append(results, &ChaincodeEvent{
 			BlockNumber:   response.GetBlockNumber(),
 			TransactionID: event.GetTxId(),
 			ChaincodeName: event.GetChaincodeId(),
 			EventName:     event.GetEventName(),
 			Payload:       event.GetPayload(),
-		}
+		})
 	}
+
+	return results
 }
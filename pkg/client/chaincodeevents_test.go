@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer"
@@ -103,7 +104,7 @@ func TestChaincodeEvents(t *testing.T) {
 		"Sends valid request with specified start block and checkpoint block": {
 			options: func() []ChaincodeEventsOption {
 				checkpointer := new(InMemoryCheckpointer)
-				checkpointer.CheckpointBlock(500)
+				require.NoError(t, checkpointer.CheckpointBlock(500))
 				return []ChaincodeEventsOption{
 					WithStartBlock(418),
 					WithCheckpoint(checkpointer),
@@ -122,7 +123,7 @@ func TestChaincodeEvents(t *testing.T) {
 		"Sends valid request with specified start block and checkpoint transaction ID": {
 			options: func() []ChaincodeEventsOption {
 				checkpointer := new(InMemoryCheckpointer)
-				checkpointer.CheckpointTransaction(500, "txn1")
+				require.NoError(t, checkpointer.CheckpointTransaction(500, "txn1"))
 				return []ChaincodeEventsOption{
 					WithStartBlock(418),
 					WithCheckpoint(checkpointer),
@@ -154,7 +155,7 @@ func TestChaincodeEvents(t *testing.T) {
 		"Sends valid request with no start block and checkpoint transaction ID": {
 			options: func() []ChaincodeEventsOption {
 				checkpointer := new(InMemoryCheckpointer)
-				checkpointer.CheckpointTransaction(500, "txn1")
+				require.NoError(t, checkpointer.CheckpointTransaction(500, "txn1"))
 				return []ChaincodeEventsOption{
 					WithCheckpoint(checkpointer),
 				}
@@ -177,7 +178,7 @@ func TestChaincodeEvents(t *testing.T) {
 					BlockNumber:   1,
 					TransactionID: "TRANSACTION_1",
 				}
-				checkpointer.CheckpointChaincodeEvent(event)
+				require.NoError(t, checkpointer.CheckpointChaincodeEvent(event))
 				return []ChaincodeEventsOption{
 					WithCheckpoint(checkpointer),
 				}
@@ -326,4 +327,519 @@ func TestChaincodeEvents(t *testing.T) {
 
 		require.Contains(t, <-options, expected, "CallOptions")
 	})
+
+	t.Run("Iterate returns connect error", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Aborted, "CHAINCODE_EVENTS_ERROR")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamError(expected))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actualErr error
+		for _, err := range request.Iterate(ctx) {
+			actualErr = err
+		}
+
+		var eventsErr *EventsError
+		require.ErrorAs(t, actualErr, &eventsErr)
+		require.Equal(t, status.Code(expected), status.Code(actualErr), "status code")
+		require.ErrorIs(t, actualErr, expected, "error type: %T", actualErr)
+		require.ErrorContains(t, actualErr, expected.Error(), "message")
+	})
+
+	t.Run("Iterate returns receive error with details", func(t *testing.T) {
+		detail := &gateway.ErrorDetail{
+			Address: "ADDRESS",
+			MspId:   "MSP_ID",
+			Message: "MESSAGE",
+		}
+		expected := NewStatusError(t, codes.PermissionDenied, "CHAINCODE_EVENTS_ERROR", detail)
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream).Return(expected)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actualErr error
+		for _, err := range request.Iterate(ctx) {
+			actualErr = err
+		}
+
+		var eventsErr *EventsError
+		require.ErrorAs(t, actualErr, &eventsErr)
+		require.Equal(t, codes.PermissionDenied, status.Code(actualErr), "status code")
+		require.Len(t, eventsErr.Details(), 1, "details")
+		AssertProtoEqual(t, detail, eventsErr.Details()[0])
+		require.ErrorContains(t, actualErr, detail.GetMessage())
+	})
+
+	t.Run("Iterate ends without error when context is cancelled", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream).Return(status.Error(codes.Canceled, "context canceled"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		for event, err := range request.Iterate(ctx) {
+			require.FailNow(t, "Unexpected iteration", "event: %v, err: %v", event, err)
+		}
+	})
+
+	t.Run("Iterate receives events", func(t *testing.T) {
+		expected := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				Payload:       []byte("PAYLOAD_1"),
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_2",
+				Payload:       []byte("PAYLOAD_2"),
+				TransactionID: "TRANSACTION_ID_2",
+			},
+			{
+				BlockNumber:   2,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_3",
+				Payload:       []byte("PAYLOAD_3"),
+				TransactionID: "TRANSACTION_ID_3",
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, WithRecvMsgs(
+			newChaincodeEventsResponse(expected[0:2]),
+			newChaincodeEventsResponse(expected[2:]),
+		))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actual []*ChaincodeEvent
+		for event, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+			actual = append(actual, event)
+		}
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Iterate ends after events from end block", func(t *testing.T) {
+		events := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   2,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_2",
+				TransactionID: "TRANSACTION_ID_2",
+			},
+			{
+				BlockNumber:   3,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_3",
+				TransactionID: "TRANSACTION_ID_3",
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, WithRecvMsgs(
+			newChaincodeEventsResponse(events[0:1]),
+			newChaincodeEventsResponse(events[1:2]),
+			newChaincodeEventsResponse(events[2:]),
+		)).Times(2)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithEndBlock(2))
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actual []*ChaincodeEvent
+		for event, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+			actual = append(actual, event)
+		}
+
+		require.Equal(t, events[0:2], actual)
+	})
+
+	t.Run("Iterate ends on events after end block", func(t *testing.T) {
+		events := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   3,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_3",
+				TransactionID: "TRANSACTION_ID_3",
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, WithRecvMsgs(
+			newChaincodeEventsResponse(events[0:1]),
+			newChaincodeEventsResponse(events[1:]),
+		))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithEndBlock(2))
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actual []*ChaincodeEvent
+		for event, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+			actual = append(actual, event)
+		}
+
+		require.Equal(t, events[0:1], actual)
+	})
+
+	t.Run("Returns error if end block is before start block", func(t *testing.T) {
+		network := AssertNewTestNetwork(t, "NETWORK")
+
+		_, err := network.NewChaincodeEventsRequest("CHAINCODE", WithStartBlock(200), WithEndBlock(100))
+
+		require.ErrorContains(t, err, "end block")
+	})
+
+	t.Run("Iterate checkpoints processed events", func(t *testing.T) {
+		events := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   2,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_2",
+				TransactionID: "TRANSACTION_ID_2",
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		requests := make(chan *gateway.SignedChaincodeEventsRequest, 1)
+		ExpectSendMsg(mockStream, CaptureSendMsg(requests))
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, WithRecvMsgs(newChaincodeEventsResponse(events)))
+
+		checkpointer := new(InMemoryCheckpointer)
+		require.NoError(t, checkpointer.CheckpointTransaction(1, "TRANSACTION_ID_0"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithCheckpointer(checkpointer))
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		for _, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+		}
+
+		actualRequest := &gateway.ChaincodeEventsRequest{}
+		AssertUnmarshal(t, (<-requests).GetRequest(), actualRequest)
+		require.Equal(t, "TRANSACTION_ID_0", actualRequest.GetAfterTransactionId(), "AfterTransactionId")
+		require.Equal(t, uint64(2), checkpointer.BlockNumber(), "BlockNumber")
+		require.Equal(t, "TRANSACTION_ID_2", checkpointer.TransactionID(), "TransactionID")
+	})
+
+	t.Run("Iterate returns checkpoint error", func(t *testing.T) {
+		expected := errors.New("CHECKPOINT_ERROR")
+		events := []*ChaincodeEvent{
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_1",
+				TransactionID: "TRANSACTION_ID_1",
+			},
+			{
+				BlockNumber:   1,
+				ChaincodeName: "CHAINCODE",
+				EventName:     "EVENT_2",
+				TransactionID: "TRANSACTION_ID_2",
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, WithRecvMsgs(newChaincodeEventsResponse(events)))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithCheckpointer(&failingCheckpointer{err: expected}))
+		require.NoError(t, err, "NewChaincodeEventsRequest")
+
+		var actual []*ChaincodeEvent
+		var actualErr error
+		for event, err := range request.Iterate(ctx) {
+			if err != nil {
+				actualErr = err
+				continue
+			}
+			actual = append(actual, event)
+		}
+
+		require.Equal(t, events[:1], actual)
+		require.ErrorIs(t, actualErr, expected)
+	})
+
+	t.Run("Reconnect", func(t *testing.T) {
+		reconnectPolicy := ReconnectPolicy{
+			Backoff: Backoff{
+				InitialDelay: time.Millisecond,
+			},
+		}
+		unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+
+		newMockStream := func(t *testing.T, requests chan<- *gateway.SignedChaincodeEventsRequest, finalErr error, responses ...*gateway.ChaincodeEventsResponse) *MockClientStream {
+			mockStream := NewMockClientStream(t)
+			ExpectSendMsg(mockStream, CaptureSendMsg(requests))
+			mockStream.EXPECT().CloseSend().Return(nil)
+			ExpectRecvMsg(mockStream, func(message any) error {
+				if len(responses) == 0 {
+					return finalErr
+				}
+
+				proto.Merge(message.(proto.Message), responses[0])
+				responses = responses[1:]
+				return nil
+			})
+			return mockStream
+		}
+
+		unmarshalRequest := func(t *testing.T, signedRequest *gateway.SignedChaincodeEventsRequest) *gateway.ChaincodeEventsRequest {
+			request := &gateway.ChaincodeEventsRequest{}
+			AssertUnmarshal(t, signedRequest.GetRequest(), request)
+			return request
+		}
+
+		t.Run("Resumes after last received event following transport failure", func(t *testing.T) {
+			expected := []*ChaincodeEvent{
+				{
+					BlockNumber:   1,
+					ChaincodeName: "CHAINCODE",
+					EventName:     "EVENT_1",
+					TransactionID: "TRANSACTION_ID_1",
+				},
+				{
+					BlockNumber:   2,
+					ChaincodeName: "CHAINCODE",
+					EventName:     "EVENT_2",
+					TransactionID: "TRANSACTION_ID_2",
+				},
+			}
+
+			requests := make(chan *gateway.SignedChaincodeEventsRequest, 2)
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(
+				newMockStream(t, requests, unavailableErr, newChaincodeEventsResponse(expected[0:1])),
+			)).Once()
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(
+				newMockStream(t, requests, io.EOF, newChaincodeEventsResponse(expected[1:])),
+			)).Once()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+			request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithStartBlock(1), WithReconnect(reconnectPolicy))
+			require.NoError(t, err, "NewChaincodeEventsRequest")
+
+			var actual []*ChaincodeEvent
+			for event, err := range request.Iterate(ctx) {
+				require.NoError(t, err)
+				actual = append(actual, event)
+			}
+
+			require.Equal(t, expected, actual, "events")
+
+			firstRequest := unmarshalRequest(t, <-requests)
+			require.Equal(t, uint64(1), firstRequest.GetStartPosition().GetSpecified().GetNumber(), "first start block")
+			require.Empty(t, firstRequest.GetAfterTransactionId(), "first after transaction ID")
+
+			secondRequest := unmarshalRequest(t, <-requests)
+			require.Equal(t, uint64(1), secondRequest.GetStartPosition().GetSpecified().GetNumber(), "second start block")
+			require.Equal(t, "TRANSACTION_ID_1", secondRequest.GetAfterTransactionId(), "second after transaction ID")
+		})
+
+		t.Run("Uses original start position if no events received", func(t *testing.T) {
+			requests := make(chan *gateway.SignedChaincodeEventsRequest, 2)
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, requests, unavailableErr))).Once()
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, requests, io.EOF))).Once()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+			request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithStartBlock(418), WithReconnect(reconnectPolicy))
+			require.NoError(t, err, "NewChaincodeEventsRequest")
+
+			for _, err := range request.Iterate(ctx) {
+				require.NoError(t, err)
+			}
+
+			for range 2 {
+				actual := unmarshalRequest(t, <-requests)
+				require.Equal(t, uint64(418), actual.GetStartPosition().GetSpecified().GetNumber(), "start block")
+			}
+		})
+
+		t.Run("Returns error after maximum reconnect attempts", func(t *testing.T) {
+			policy := reconnectPolicy
+			policy.MaxAttempts = 2
+
+			requests := make(chan *gateway.SignedChaincodeEventsRequest, 3)
+			mockConnection := NewMockClientConnInterface(t)
+			for range 3 {
+				ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, requests, unavailableErr))).Once()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+			request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithReconnect(policy))
+			require.NoError(t, err, "NewChaincodeEventsRequest")
+
+			var actualErr error
+			for _, err := range request.Iterate(ctx) {
+				actualErr = err
+			}
+
+			require.Equal(t, codes.Unavailable, status.Code(actualErr), "status code")
+		})
+
+		t.Run("Does not reconnect on non-transport error", func(t *testing.T) {
+			expected := NewStatusError(t, codes.PermissionDenied, "PERMISSION_DENIED")
+
+			requests := make(chan *gateway.SignedChaincodeEventsRequest, 1)
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, requests, expected))).Once()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+			request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithReconnect(reconnectPolicy))
+			require.NoError(t, err, "NewChaincodeEventsRequest")
+
+			var actualErr error
+			for _, err := range request.Iterate(ctx) {
+				actualErr = err
+			}
+
+			require.ErrorIs(t, actualErr, expected)
+		})
+
+		t.Run("Event channel reconnects", func(t *testing.T) {
+			expected := []*ChaincodeEvent{
+				{
+					BlockNumber:   1,
+					ChaincodeName: "CHAINCODE",
+					TransactionID: "TRANSACTION_ID_1",
+				},
+				{
+					BlockNumber:   2,
+					ChaincodeName: "CHAINCODE",
+					TransactionID: "TRANSACTION_ID_2",
+				},
+			}
+
+			requests := make(chan *gateway.SignedChaincodeEventsRequest, 2)
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(
+				newMockStream(t, requests, unavailableErr, newChaincodeEventsResponse(expected[0:1])),
+			)).Once()
+			ExpectChaincodeEvents(mockConnection, WithNewStreamResult(
+				newMockStream(t, requests, io.EOF, newChaincodeEventsResponse(expected[1:])),
+			)).Once()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection))
+			receive, err := network.ChaincodeEvents(ctx, "CHAINCODE", WithReconnect(reconnectPolicy))
+			require.NoError(t, err)
+
+			var actual []*ChaincodeEvent
+			for event := range receive {
+				actual = append(actual, event)
+			}
+
+			require.Equal(t, expected, actual)
+		})
+	})
 }
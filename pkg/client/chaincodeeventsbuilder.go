@@ -16,6 +16,10 @@ type chaincodeEventsBuilder struct {
 }
 
 func (builder *chaincodeEventsBuilder) build() (*ChaincodeEventsRequest, error) {
+	if err := builder.validate(); err != nil {
+		return nil, err
+	}
+
 	signedRequest, err := builder.newSignedChaincodeEventsRequestProto()
 	if err != nil {
 		return nil, err
@@ -25,10 +29,25 @@ func (builder *chaincodeEventsBuilder) build() (*ChaincodeEventsRequest, error)
 		client:        builder.client,
 		signingID:     builder.signingID,
 		signedRequest: signedRequest,
+		checkpointer:  builder.checkpointer,
+		endBlock:      builder.endBlock,
+	}
+	if builder.reconnectPolicy != nil {
+		result.builder = builder
 	}
 	return result, nil
 }
 
+// rebuild creates a new request that resumes eventing from the checkpoint position.
+func (builder *chaincodeEventsBuilder) rebuild(checkpoint Checkpoint) (*ChaincodeEventsRequest, error) {
+	next := *builder
+	if err := WithCheckpoint(checkpoint)(&next.eventsBuilder); err != nil {
+		return nil, err
+	}
+
+	return next.build()
+}
+
 func (builder *chaincodeEventsBuilder) newSignedChaincodeEventsRequestProto() (*gateway.SignedChaincodeEventsRequest, error) {
 	request, err := builder.newChaincodeEventsRequestProto()
 	if err != nil {
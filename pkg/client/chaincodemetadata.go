@@ -0,0 +1,70 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
+)
+
+// Metadata evaluates the GetMetadata transaction function of the chaincode system contract, and returns the parsed
+// metadata describing the contracts, transaction functions and schemas within the chaincode. This is available only
+// for chaincode implemented using a Fabric contract API.
+//
+// The metadata can be used with [WithMetadataValidation] to check transaction function arguments before a proposal is
+// created and signed.
+func (contract *Contract) Metadata() (*metadata.Metadata, error) {
+	result, err := contract.systemContract().Evaluate(metadata.GetMetadataTransactionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Parse(result)
+}
+
+// MetadataWithContext evaluates the GetMetadata transaction function of the chaincode system contract in the scope of
+// a specific context, and returns the parsed metadata. See [Contract.Metadata] for details.
+func (contract *Contract) MetadataWithContext(ctx context.Context) (*metadata.Metadata, error) {
+	result, err := contract.systemContract().EvaluateWithContext(ctx, metadata.GetMetadataTransactionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.Parse(result)
+}
+
+func (contract *Contract) systemContract() *Contract {
+	return &Contract{
+		client:        contract.client,
+		signingID:     contract.signingID,
+		channelName:   contract.channelName,
+		chaincodeName: contract.chaincodeName,
+		contractName:  metadata.SystemContractName,
+	}
+}
+
+// WithMetadataValidation checks the transaction function arguments associated with a transaction proposal against the
+// parameter schemas described by chaincode metadata, such as that obtained from [Contract.Metadata]. The check is
+// made once all proposal options have been applied, and the proposal is not created if the arguments are invalid.
+// This avoids obtaining endorsements for transaction invocations that the chaincode would reject.
+func WithMetadataValidation(chaincodeMetadata *metadata.Metadata) ProposalOption {
+	return func(builder *proposalBuilder) error {
+		builder.chaincodeMetadata = chaincodeMetadata
+		return nil
+	}
+}
+
+func (builder *proposalBuilder) validateArguments() error {
+	if builder.chaincodeMetadata == nil {
+		return nil
+	}
+
+	if err := builder.chaincodeMetadata.ValidateArguments(builder.transactionName, builder.args); err != nil {
+		return fmt.Errorf("invalid arguments for transaction %s: %w", builder.transactionName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const testMetadataJSON = `{
+	"contracts": {
+		"AssetContract": {
+			"name": "AssetContract",
+			"transactions": [
+				{
+					"name": "CreateAsset",
+					"tag": ["submit"],
+					"parameters": [
+						{"name": "id", "schema": {"type": "string"}},
+						{"name": "size", "schema": {"type": "integer"}}
+					]
+				}
+			]
+		}
+	}
+}`
+
+func assertParseTestMetadata(t *testing.T) *metadata.Metadata {
+	result, err := metadata.Parse([]byte(testMetadataJSON))
+	require.NoError(t, err)
+	return result
+}
+
+func TestContractMetadata(t *testing.T) {
+	for name, getMetadata := range map[string]func(*Contract) (*metadata.Metadata, error){
+		"Metadata": func(contract *Contract) (*metadata.Metadata, error) {
+			return contract.Metadata()
+		},
+		"MetadataWithContext": func(contract *Contract) (*metadata.Metadata, error) {
+			return contract.MetadataWithContext(context.Background())
+		},
+	} {
+		t.Run(name+" evaluates system contract GetMetadata", func(t *testing.T) {
+			mockConnection := NewMockClientConnInterface(t)
+			requests := make(chan *gateway.EvaluateRequest, 1)
+			ExpectEvaluate(mockConnection, CaptureInvokeRequest(requests), WithEvaluateResponse([]byte(testMetadataJSON)))
+
+			contract := AssertNewTestContractWithName(t, "CHAINCODE", "AssetContract", WithClientConnection(mockConnection))
+			_, err := getMetadata(contract)
+			require.NoError(t, err)
+
+			args := AssertUnmarshalInvocationSpec(t, (<-requests).GetProposedTransaction()).GetChaincodeSpec().GetInput().GetArgs()
+			require.Equal(t, [][]byte{[]byte("org.hyperledger.fabric:GetMetadata")}, args)
+		})
+
+		t.Run(name+" returns parsed metadata", func(t *testing.T) {
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectEvaluate(mockConnection, WithEvaluateResponse([]byte(testMetadataJSON)))
+
+			contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection))
+			actual, err := getMetadata(contract)
+			require.NoError(t, err)
+
+			require.Equal(t, assertParseTestMetadata(t), actual)
+		})
+	}
+
+	t.Run("Returns evaluate errors", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Unavailable, "EVALUATE_ERROR")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(expected))
+
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection))
+		_, err := contract.Metadata()
+
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Returns parse errors", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse([]byte("NOT_JSON")))
+
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection))
+		_, err := contract.Metadata()
+
+		require.Error(t, err)
+	})
+}
+
+func TestWithMetadataValidation(t *testing.T) {
+	t.Run("Creates proposal with valid arguments", func(t *testing.T) {
+		contract := AssertNewTestContractWithName(t, "CHAINCODE", "AssetContract")
+
+		_, err := contract.NewProposal(
+			"CreateAsset",
+			WithMetadataValidation(assertParseTestMetadata(t)),
+			WithArguments("asset1"),
+			WithTypedArguments(JSONCodec, 10),
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("Fails to create proposal with invalid arguments", func(t *testing.T) {
+		contract := AssertNewTestContractWithName(t, "CHAINCODE", "AssetContract")
+
+		_, err := contract.NewProposal(
+			"CreateAsset",
+			WithMetadataValidation(assertParseTestMetadata(t)),
+			WithArguments("asset1", "ten"),
+		)
+		require.ErrorContains(t, err, "argument 1 (size)")
+	})
+
+	t.Run("Does not endorse invalid arguments", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+
+		contract := AssertNewTestContractWithName(t, "CHAINCODE", "AssetContract", WithClientConnection(mockConnection))
+		_, err := contract.Submit("CreateAsset", WithMetadataValidation(assertParseTestMetadata(t)), WithArguments("asset1"))
+
+		require.ErrorContains(t, err, "expects 2 arguments, got 1")
+	})
+
+	t.Run("Fails for transaction not in metadata", func(t *testing.T) {
+		contract := AssertNewTestContractWithName(t, "CHAINCODE", "AssetContract")
+
+		_, err := contract.NewProposal("DeleteAsset", WithMetadataValidation(assertParseTestMetadata(t)))
+		require.ErrorContains(t, err, "DeleteAsset")
+	})
+}
@@ -0,0 +1,21 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+// Checkpointer records the current position for event processing. Checkpoint position is updated after events are
+// successfully processed, allowing eventing to be resumed from that point using the [WithCheckpoint] or
+// [WithCheckpointer] options.
+//
+// [InMemoryCheckpointer] and [FileCheckpointer] implementations are provided. Applications may provide their own
+// implementations to store checkpoint state in other durable stores, such as a database.
+type Checkpointer interface {
+	// CheckpointBlock records a successfully processed block.
+	CheckpointBlock(blockNumber uint64) error
+	// CheckpointTransaction records a successfully processed transaction within a given block.
+	CheckpointTransaction(blockNumber uint64, transactionID string) error
+	// CheckpointChaincodeEvent records a successfully processed chaincode event.
+	CheckpointChaincodeEvent(event *ChaincodeEvent) error
+
+	Checkpoint
+}
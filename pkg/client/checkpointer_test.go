@@ -23,15 +23,6 @@ func NonExistentFileName(t *testing.T, dir string) string {
 }
 
 func TestCheckpointer(t *testing.T) {
-	type Checkpointer interface {
-		CheckpointBlock(uint64) error
-		CheckpointTransaction(uint64, string) error
-		CheckpointChaincodeEvent(*ChaincodeEvent) error
-		Close() error
-
-		Checkpoint
-	}
-
 	assertState := func(t *testing.T, checkpoint Checkpoint, blockNumber uint64, transactionID string) {
 		require.Equal(t, blockNumber, checkpoint.BlockNumber(), "BlockNumber")
 		require.Equal(t, transactionID, checkpoint.TransactionID(), "TransactionID")
@@ -44,9 +35,7 @@ func TestCheckpointer(t *testing.T) {
 	}{
 		"In-memory": {
 			newCheckpointer: func(t *testing.T) Checkpointer {
-				return &InMemoryAdapter{
-					InMemoryCheckpointer{},
-				}
+				return new(InMemoryCheckpointer)
 			},
 		},
 		"File": {
@@ -54,6 +43,21 @@ func TestCheckpointer(t *testing.T) {
 				fileName := NonExistentFileName(t, tempDir)
 				checkpointer, err := NewFileCheckpointer(fileName)
 				require.NoError(t, err)
+				t.Cleanup(func() {
+					require.NoError(t, checkpointer.Close())
+				})
+
+				return checkpointer
+			},
+		},
+		"File with atomic writes": {
+			newCheckpointer: func(t *testing.T) Checkpointer {
+				fileName := NonExistentFileName(t, tempDir)
+				checkpointer, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+				require.NoError(t, err)
+				t.Cleanup(func() {
+					require.NoError(t, checkpointer.Close())
+				})
 
 				return checkpointer
 			},
@@ -62,7 +66,6 @@ func TestCheckpointer(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			t.Run("Initial checkpointer state", func(t *testing.T) {
 				checkpointer := testCase.newCheckpointer(t)
-				defer checkpointer.Close()
 
 				assertState(t, checkpointer, uint64(0), "")
 			})
@@ -70,7 +73,6 @@ func TestCheckpointer(t *testing.T) {
 			t.Run("CheckpointBlock() sets next block number and empty transaction ID", func(t *testing.T) {
 				blockNumber := uint64(101)
 				checkpointer := testCase.newCheckpointer(t)
-				defer checkpointer.Close()
 
 				err := checkpointer.CheckpointBlock(blockNumber)
 				require.NoError(t, err)
@@ -81,7 +83,6 @@ func TestCheckpointer(t *testing.T) {
 			t.Run("CheckpointTransaction() sets block number and transaction ID", func(t *testing.T) {
 				blockNumber := uint64(101)
 				checkpointer := testCase.newCheckpointer(t)
-				defer checkpointer.Close()
 
 				err := checkpointer.CheckpointTransaction(blockNumber, "txn1")
 				require.NoError(t, err)
@@ -95,7 +96,6 @@ func TestCheckpointer(t *testing.T) {
 					TransactionID: "txn1",
 				}
 				checkpointer := testCase.newCheckpointer(t)
-				defer checkpointer.Close()
 
 				err := checkpointer.CheckpointChaincodeEvent(event)
 				require.NoError(t, err)
@@ -106,25 +106,19 @@ func TestCheckpointer(t *testing.T) {
 	}
 }
 
-type InMemoryAdapter struct {
+type failingCheckpointer struct {
 	InMemoryCheckpointer
+	err error
 }
 
-func (adapter *InMemoryAdapter) CheckpointBlock(blockNumber uint64) error {
-	adapter.InMemoryCheckpointer.CheckpointBlock(blockNumber)
-	return nil
-}
-
-func (adapter *InMemoryAdapter) CheckpointTransaction(blockNumber uint64, transactionID string) error {
-	adapter.InMemoryCheckpointer.CheckpointTransaction(blockNumber, transactionID)
-	return nil
+func (checkpointer *failingCheckpointer) CheckpointBlock(uint64) error {
+	return checkpointer.err
 }
 
-func (adapter *InMemoryAdapter) CheckpointChaincodeEvent(event *ChaincodeEvent) error {
-	adapter.InMemoryCheckpointer.CheckpointChaincodeEvent(event)
-	return nil
+func (checkpointer *failingCheckpointer) CheckpointTransaction(uint64, string) error {
+	return checkpointer.err
 }
 
-func (adapter *InMemoryAdapter) Close() error {
-	return nil
+func (checkpointer *failingCheckpointer) CheckpointChaincodeEvent(*ChaincodeEvent) error {
+	return checkpointer.err
 }
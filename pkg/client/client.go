@@ -5,10 +5,13 @@ package client
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
@@ -17,16 +20,30 @@ type gatewayClient struct {
 	grpcGatewayClient gateway.GatewayClient
 	grpcDeliverClient peer.DeliverClient
 	contexts          *contextFactory
+	retryPolicy       *RetryPolicy
+	interceptors      []Interceptor
+	metrics           *clientMetrics
+	logger            *slog.Logger
+	tracker           *operationTracker
+	tracer            trace.Tracer
+	propagator        propagation.TextMapPropagator
 }
 
-func (client *gatewayClient) Endorse(in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error) {
-	ctx, cancel := client.contexts.Endorse()
-	defer cancel()
-	return client.EndorseWithContext(ctx, in, opts...)
-}
+type deliverStream = grpc.BidiStreamingClient[common.Envelope, peer.DeliverResponse]
 
 func (client *gatewayClient) EndorseWithContext(ctx context.Context, in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error) {
-	response, err := client.grpcGatewayClient.Endorse(ctx, in, opts...)
+	newInfo := newProposalOperationInfo(OperationEndorse, in.GetChannelId(), in.GetTransactionId(), in.GetProposedTransaction())
+	return track(client.tracker, OperationEndorse, in.GetTransactionId(), func() (*gateway.EndorseResponse, error) {
+		return intercept(ctx, client.interceptors, newInfo, func(ctx context.Context) (*gateway.EndorseResponse, error) {
+			return client.endorse(ctx, in, opts...)
+		})
+	})
+}
+
+func (client *gatewayClient) endorse(ctx context.Context, in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error) {
+	response, err := invokeWithRetry(ctx, client.retryPolicy, client.retryPolicy.endorseMaxAttempts(), func() (*gateway.EndorseResponse, error) {
+		return client.grpcGatewayClient.Endorse(client.withTraceContext(ctx), in, opts...)
+	})
 	if err != nil {
 		txErr := newTransactionError(err, in.GetTransactionId())
 		return nil, &EndorseError{txErr}
@@ -35,14 +52,18 @@ func (client *gatewayClient) EndorseWithContext(ctx context.Context, in *gateway
 	return response, nil
 }
 
-func (client *gatewayClient) Submit(in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
-	ctx, cancel := client.contexts.Submit()
-	defer cancel()
-	return client.SubmitWithContext(ctx, in, opts...)
+// SubmitWithContext submits a transaction. The caller is responsible for tracking the submit as an in-progress
+// operation until the commit status of the transaction is known.
+func (client *gatewayClient) SubmitWithContext(ctx context.Context, in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
+	return intercept(ctx, client.interceptors, newSubmitOperationInfo(in), func(ctx context.Context) (*gateway.SubmitResponse, error) {
+		return client.submit(ctx, in, opts...)
+	})
 }
 
-func (client *gatewayClient) SubmitWithContext(ctx context.Context, in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
-	response, err := client.grpcGatewayClient.Submit(ctx, in, opts...)
+func (client *gatewayClient) submit(ctx context.Context, in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
+	response, err := invokeWithRetry(ctx, client.retryPolicy, client.retryPolicy.submitMaxAttempts(), func() (*gateway.SubmitResponse, error) {
+		return client.grpcGatewayClient.Submit(client.withTraceContext(ctx), in, opts...)
+	})
 	if err != nil {
 		txErr := newTransactionError(err, in.GetTransactionId())
 		return nil, &SubmitError{txErr}
@@ -51,20 +72,34 @@ func (client *gatewayClient) SubmitWithContext(ctx context.Context, in *gateway.
 	return response, nil
 }
 
-func (client *gatewayClient) CommitStatus(in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
-	ctx, cancel := client.contexts.CommitStatus()
-	defer cancel()
-	return client.CommitStatusWithContext(ctx, in, opts...)
+func (client *gatewayClient) CommitStatusWithContext(ctx context.Context, in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
+	transactionID := getTransactionIDFromSignedCommitStatusRequest(in)
+	response, err := track(client.tracker, OperationCommitStatus, transactionID, func() (*gateway.CommitStatusResponse, error) {
+		return intercept(ctx, client.interceptors, newCommitStatusOperationInfo(in), func(ctx context.Context) (*gateway.CommitStatusResponse, error) {
+			return client.commitStatus(ctx, in, opts...)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.tracker.completeSubmit(transactionID)
+	return response, nil
 }
 
-func (client *gatewayClient) CommitStatusWithContext(ctx context.Context, in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
-	response, err := client.grpcGatewayClient.CommitStatus(ctx, in, opts...)
+func (client *gatewayClient) commitStatus(ctx context.Context, in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
+	response, err := invokeWithRetry(ctx, client.retryPolicy, client.retryPolicy.commitStatusMaxAttempts(), func() (*gateway.CommitStatusResponse, error) {
+		return client.grpcGatewayClient.CommitStatus(client.withTraceContext(ctx), in, opts...)
+	})
 	if err != nil {
 		transactionID := getTransactionIDFromSignedCommitStatusRequest(in)
 		txErr := newTransactionError(err, transactionID)
 		return nil, &CommitStatusError{txErr}
 	}
 
+	client.metrics.recordCommitStatus(in, response)
+	client.logCommitStatus(ctx, in, response)
+
 	return response, nil
 }
 
@@ -77,55 +112,87 @@ func getTransactionIDFromSignedCommitStatusRequest(in *gateway.SignedCommitStatu
 	return request.GetTransactionId()
 }
 
-func (client *gatewayClient) Evaluate(in *gateway.EvaluateRequest, opts ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
-	ctx, cancel := client.contexts.Evaluate()
-	defer cancel()
-	return client.EvaluateWithContext(ctx, in, opts...)
-}
-
 func (client *gatewayClient) EvaluateWithContext(ctx context.Context, in *gateway.EvaluateRequest, opts ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
-	return client.grpcGatewayClient.Evaluate(ctx, in, opts...)
+	newInfo := newProposalOperationInfo(OperationEvaluate, in.GetChannelId(), in.GetTransactionId(), in.GetProposedTransaction())
+	return track(client.tracker, OperationEvaluate, in.GetTransactionId(), func() (*gateway.EvaluateResponse, error) {
+		return intercept(ctx, client.interceptors, newInfo, func(ctx context.Context) (*gateway.EvaluateResponse, error) {
+			return invokeWithRetry(ctx, client.retryPolicy, client.retryPolicy.evaluateMaxAttempts(), func() (*gateway.EvaluateResponse, error) {
+				return client.grpcGatewayClient.Evaluate(client.withTraceContext(ctx), in, opts...)
+			})
+		})
+	})
 }
 
 func (client *gatewayClient) ChaincodeEvents(ctx context.Context, in *gateway.SignedChaincodeEventsRequest, opts ...grpc.CallOption) (gateway.Gateway_ChaincodeEventsClient, error) {
-	return client.grpcGatewayClient.ChaincodeEvents(ctx, in, opts...)
-}
-
-func (client *gatewayClient) BlockEvents(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (peer.Deliver_DeliverClient, error) {
-	deliverClient, err := client.grpcDeliverClient.Deliver(ctx, opts...)
+	tracked, err := client.tracker.beginStream(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := deliverClient.Send(in); err != nil {
+	newInfo := newChaincodeEventsOperationInfo(in)
+	stream, err := intercept(tracked.ctx, client.interceptors, newInfo, func(ctx context.Context) (gateway.Gateway_ChaincodeEventsClient, error) {
+		return client.grpcGatewayClient.ChaincodeEvents(client.withTraceContext(ctx), in, opts...)
+	})
+	if err != nil {
+		tracked.end()
 		return nil, err
 	}
 
-	return deliverClient, nil
+	return &chaincodeEventsStream{
+		Gateway_ChaincodeEventsClient: stream,
+		tracked:                       tracked,
+		client:                        client,
+		channelName:                   newInfo().ChannelName,
+	}, nil
 }
 
-func (client *gatewayClient) FilteredBlockEvents(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (peer.Deliver_DeliverFilteredClient, error) {
-	deliverClient, err := client.grpcDeliverClient.DeliverFiltered(ctx, opts...)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := deliverClient.Send(in); err != nil {
-		return nil, err
-	}
+func (client *gatewayClient) BlockEvents(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (peer.Deliver_DeliverClient, error) {
+	return client.deliverEvents(ctx, OperationBlockEvents, in, client.grpcDeliverClient.Deliver, opts...)
+}
 
-	return deliverClient, nil
+func (client *gatewayClient) FilteredBlockEvents(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (peer.Deliver_DeliverFilteredClient, error) {
+	return client.deliverEvents(ctx, OperationFilteredBlockEvents, in, client.grpcDeliverClient.DeliverFiltered, opts...)
 }
 
 func (client *gatewayClient) BlockAndPrivateDataEvents(ctx context.Context, in *common.Envelope, opts ...grpc.CallOption) (peer.Deliver_DeliverWithPrivateDataClient, error) {
-	deliverClient, err := client.grpcDeliverClient.DeliverWithPrivateData(ctx, opts...)
+	return client.deliverEvents(ctx, OperationBlockAndPrivateDataEvents, in, client.grpcDeliverClient.DeliverWithPrivateData, opts...)
+}
+
+func (client *gatewayClient) deliverEvents(
+	ctx context.Context,
+	operation Operation,
+	in *common.Envelope,
+	deliver func(context.Context, ...grpc.CallOption) (deliverStream, error),
+	opts ...grpc.CallOption,
+) (deliverStream, error) {
+	tracked, err := client.tracker.beginStream(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := deliverClient.Send(in); err != nil {
+	newInfo := newBlockEventsOperationInfo(operation, in)
+	stream, err := intercept(tracked.ctx, client.interceptors, newInfo, func(ctx context.Context) (deliverStream, error) {
+		deliverClient, err := deliver(client.withTraceContext(ctx), opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := deliverClient.Send(in); err != nil {
+			return nil, err
+		}
+
+		return deliverClient, nil
+	})
+	if err != nil {
+		tracked.end()
 		return nil, err
 	}
 
-	return deliverClient, nil
+	return &deliverEventsStream{
+		deliverStream: stream,
+		tracked:       tracked,
+		client:        client,
+		operation:     operation,
+		channelName:   newInfo().ChannelName,
+	}, nil
 }
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec encodes transaction function arguments and decodes transaction function results. A Codec is used with
+// [WithTypedArguments] to supply arguments, and with [EvaluateAs] or [SubmitAs] to obtain results as Go values.
+type Codec interface {
+	// Marshal encodes a value as a transaction function argument.
+	Marshal(value any) ([]byte, error)
+	// Unmarshal decodes a transaction function result into the value pointed to by value.
+	Unmarshal(data []byte, value any) error
+}
+
+// JSONCodec encodes and decodes values as JSON using the [encoding/json] package. To match the serialization used by
+// Fabric contract API chaincode, string and []byte values are passed through raw rather than encoded as JSON, and
+// results decoded into a *string or *[]byte are the raw result bytes.
+var JSONCodec Codec = jsonCodec{}
+
+// ProtobufCodec encodes and decodes protobuf messages using their binary wire format. Values must be protobuf
+// messages. Results may be decoded into either a message or the address of a message pointer, which is allocated if
+// nil.
+var ProtobufCodec Codec = protobufCodec{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return json.Marshal(value)
+	}
+}
+
+func (jsonCodec) Unmarshal(data []byte, value any) error {
+	switch v := value.(type) {
+	case *string:
+		*v = string(data)
+		return nil
+	case *[]byte:
+		*v = slices.Clone(data)
+		return nil
+	default:
+		return json.Unmarshal(data, value)
+	}
+}
+
+type protobufCodec struct{}
+
+func (protobufCodec) Marshal(value any) ([]byte, error) {
+	message, ok := value.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("protobuf codec cannot marshal non-protobuf value of type %T", value)
+	}
+
+	return proto.Marshal(message)
+}
+
+func (protobufCodec) Unmarshal(data []byte, value any) error {
+	message, err := protoMessageTarget(value)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(data, message)
+}
+
+// protoMessageTarget returns the message into which a value should be decoded. The value can be either a message or the
+// address of a message pointer, in which case a new message is allocated if the pointer is nil.
+func protoMessageTarget(value any) (proto.Message, error) {
+	if message, ok := value.(proto.Message); ok {
+		return message, nil
+	}
+
+	pointer := reflect.ValueOf(value)
+	if pointer.Kind() == reflect.Pointer && !pointer.IsNil() && pointer.Elem().Kind() == reflect.Pointer {
+		target := pointer.Elem()
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		if message, ok := target.Interface().(proto.Message); ok {
+			return message, nil
+		}
+	}
+
+	return nil, fmt.Errorf("protobuf codec cannot unmarshal into non-protobuf value of type %T", value)
+}
+
+// WithTypedArguments appends to the transaction function arguments associated with a transaction proposal. Each value
+// is encoded using the supplied codec.
+func WithTypedArguments(codec Codec, values ...any) ProposalOption {
+	return func(builder *proposalBuilder) error {
+		for i, value := range values {
+			arg, err := codec.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("failed to encode argument %d: %w", i, err)
+			}
+			builder.args = append(builder.args, arg)
+		}
+		return nil
+	}
+}
+
+// EvaluateAs evaluates a transaction function and decodes its result into a value of type T using the supplied codec.
+// See [Contract.Evaluate] for details of the evaluation.
+func EvaluateAs[T any](contract *Contract, codec Codec, transactionName string, options ...ProposalOption) (T, error) {
+	result, err := contract.Evaluate(transactionName, options...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decodeResult[T](codec, result)
+}
+
+// EvaluateAsWithContext evaluates a transaction function in the scope of a specific context and decodes its result
+// into a value of type T using the supplied codec. See [Contract.EvaluateWithContext] for details of the evaluation.
+func EvaluateAsWithContext[T any](
+	ctx context.Context,
+	contract *Contract,
+	codec Codec,
+	transactionName string,
+	options ...ProposalOption,
+) (T, error) {
+	result, err := contract.EvaluateWithContext(ctx, transactionName, options...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decodeResult[T](codec, result)
+}
+
+// SubmitAs submits a transaction to the ledger and, once it has been committed, decodes its result into a value of type
+// T using the supplied codec. See [Contract.Submit] for details of the submit and the errors it may return. If the
+// transaction is committed successfully but its result cannot be decoded, a decode error is returned.
+func SubmitAs[T any](contract *Contract, codec Codec, transactionName string, options ...ProposalOption) (T, error) {
+	result, err := contract.Submit(transactionName, options...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decodeResult[T](codec, result)
+}
+
+// SubmitAsWithContext submits a transaction to the ledger in the scope of a specific context and, once it has been
+// committed, decodes its result into a value of type T using the supplied codec. See [Contract.SubmitWithContext] for
+// details of the submit and the errors it may return. If the transaction is committed successfully but its result
+// cannot be decoded, a decode error is returned.
+func SubmitAsWithContext[T any](
+	ctx context.Context,
+	contract *Contract,
+	codec Codec,
+	transactionName string,
+	options ...ProposalOption,
+) (T, error) {
+	result, err := contract.SubmitWithContext(ctx, transactionName, options...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return decodeResult[T](codec, result)
+}
+
+func decodeResult[T any](codec Codec, data []byte) (T, error) {
+	var result T
+	if err := codec.Unmarshal(data, &result); err != nil {
+		var zero T
+		return zero, fmt.Errorf("failed to decode transaction result: %w", err)
+	}
+
+	return result, nil
+}
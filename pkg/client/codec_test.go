@@ -0,0 +1,184 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+type codecTestAsset struct {
+	ID    string `json:"id"`
+	Value int    `json:"value"`
+}
+
+func TestCodec(t *testing.T) {
+	t.Run("WithTypedArguments encodes JSON arguments", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		requests := make(chan *gateway.EvaluateRequest, 1)
+		ExpectEvaluate(mockConnection, CaptureInvokeRequest(requests), WithEvaluateResponse(nil))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := contract.Evaluate(
+			"transaction",
+			WithArguments("ARG"),
+			WithTypedArguments(JSONCodec, codecTestAsset{ID: "ASSET", Value: 1}, 2),
+		)
+		require.NoError(t, err)
+
+		actual := AssertUnmarshalInvocationSpec(t, (<-requests).GetProposedTransaction()).GetChaincodeSpec().GetInput().GetArgs()
+		expected := [][]byte{[]byte("transaction"), []byte("ARG"), []byte(`{"id":"ASSET","value":1}`), []byte("2")}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("WithTypedArguments passes string and byte slice arguments raw", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		requests := make(chan *gateway.EvaluateRequest, 1)
+		ExpectEvaluate(mockConnection, CaptureInvokeRequest(requests), WithEvaluateResponse(nil))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := contract.Evaluate(
+			"transaction",
+			WithTypedArguments(JSONCodec, "asset1", []byte("BYTES")),
+		)
+		require.NoError(t, err)
+
+		actual := AssertUnmarshalInvocationSpec(t, (<-requests).GetProposedTransaction()).GetChaincodeSpec().GetInput().GetArgs()
+		expected := [][]byte{[]byte("transaction"), []byte("asset1"), []byte("BYTES")}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("EvaluateAs decodes raw string and byte slice results", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse([]byte("asset1"))).Times(2)
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		actualString, err := EvaluateAs[string](contract, JSONCodec, "transaction")
+		require.NoError(t, err)
+		require.Equal(t, "asset1", actualString)
+
+		actualBytes, err := EvaluateAs[[]byte](contract, JSONCodec, "transaction")
+		require.NoError(t, err)
+		require.Equal(t, []byte("asset1"), actualBytes)
+	})
+
+	t.Run("WithTypedArguments encodes protobuf arguments", func(t *testing.T) {
+		argument := &peer.ChaincodeID{Name: "NAME", Version: "VERSION"}
+
+		mockConnection := NewMockClientConnInterface(t)
+		requests := make(chan *gateway.EvaluateRequest, 1)
+		ExpectEvaluate(mockConnection, CaptureInvokeRequest(requests), WithEvaluateResponse(nil))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := contract.Evaluate("transaction", WithTypedArguments(ProtobufCodec, argument))
+		require.NoError(t, err)
+
+		args := AssertUnmarshalInvocationSpec(t, (<-requests).GetProposedTransaction()).GetChaincodeSpec().GetInput().GetArgs()
+		require.Len(t, args, 2)
+		actual := &peer.ChaincodeID{}
+		AssertUnmarshal(t, args[1], actual)
+		AssertProtoEqual(t, argument, actual)
+	})
+
+	t.Run("WithTypedArguments returns encode errors", func(t *testing.T) {
+		contract := AssertNewTestContract(t, "chaincode")
+
+		_, err := contract.NewProposal("transaction", WithTypedArguments(ProtobufCodec, "NOT_PROTOBUF"))
+		require.ErrorContains(t, err, "argument 0")
+	})
+
+	t.Run("EvaluateAs decodes JSON result", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse([]byte(`{"id":"ASSET","value":1}`)))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		actual, err := EvaluateAs[codecTestAsset](contract, JSONCodec, "transaction")
+		require.NoError(t, err)
+
+		require.Equal(t, codecTestAsset{ID: "ASSET", Value: 1}, actual)
+	})
+
+	t.Run("EvaluateAsWithContext decodes protobuf result", func(t *testing.T) {
+		expected := &peer.ChaincodeID{Name: "NAME", Version: "VERSION"}
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(AssertMarshal(t, expected)))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		actual, err := EvaluateAsWithContext[*peer.ChaincodeID](context.Background(), contract, ProtobufCodec, "transaction")
+		require.NoError(t, err)
+
+		AssertProtoEqual(t, expected, actual)
+	})
+
+	t.Run("EvaluateAs returns evaluate errors", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(expected))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := EvaluateAs[codecTestAsset](contract, JSONCodec, "transaction")
+
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("EvaluateAs returns decode errors", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse([]byte("NOT_JSON")))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := EvaluateAs[codecTestAsset](contract, JSONCodec, "transaction")
+
+		require.ErrorContains(t, err, "decode")
+	})
+
+	t.Run("ProtobufCodec fails to decode into non-protobuf value", func(t *testing.T) {
+		var value string
+		err := ProtobufCodec.Unmarshal(nil, &value)
+
+		require.Error(t, err)
+	})
+
+	for name, submit := range map[string]func(*Contract) (codecTestAsset, error){
+		"SubmitAs": func(contract *Contract) (codecTestAsset, error) {
+			return SubmitAs[codecTestAsset](contract, JSONCodec, "transaction")
+		},
+		"SubmitAsWithContext": func(contract *Contract) (codecTestAsset, error) {
+			return SubmitAsWithContext[codecTestAsset](context.Background(), contract, JSONCodec, "transaction")
+		},
+	} {
+		t.Run(name+" decodes JSON result", func(t *testing.T) {
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, `{"id":"ASSET","value":1}`, "network")))
+			ExpectSubmit(mockConnection)
+			ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+			contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+			actual, err := submit(contract)
+			require.NoError(t, err)
+
+			require.Equal(t, codecTestAsset{ID: "ASSET", Value: 1}, actual)
+		})
+	}
+
+	t.Run("SubmitAs returns commit errors", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, `{"id":"ASSET","value":1}`, "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 1))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		_, err := SubmitAs[codecTestAsset](contract, JSONCodec, "transaction")
+
+		var commitErr *CommitError
+		require.ErrorAs(t, err, &commitErr)
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
@@ -58,29 +60,25 @@ func (commit *Commit) TransactionID() string {
 // Status of the committed transaction. If the transaction has not yet committed, this call blocks until the commit
 // occurs.
 func (commit *Commit) Status(opts ...grpc.CallOption) (*Status, error) {
-	return commit.status(commit.client.CommitStatus, opts...)
+	ctx, cancel := commit.client.contexts.CommitStatus()
+	defer cancel()
+	return commit.StatusWithContext(ctx, opts...)
 }
 
 // StatusWithContext uses the supplied context to get the status of the committed transaction. If the transaction has
 // not yet committed, this call blocks until the commit occurs.
 func (commit *Commit) StatusWithContext(ctx context.Context, opts ...grpc.CallOption) (*Status, error) {
-	return commit.status(
-		func(in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
-			return commit.client.CommitStatusWithContext(ctx, in, opts...)
-		},
-		opts...,
-	)
+	return withSpan(ctx, commit.client, "CommitStatus", trace.SpanKindClient, commit.attributes, func(ctx context.Context) (*Status, error) {
+		return commit.status(ctx, opts...)
+	}, (*Status).attributes)
 }
 
-func (commit *Commit) status(
-	call func(in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error),
-	opts ...grpc.CallOption,
-) (*Status, error) {
-	if err := commit.sign(); err != nil {
+func (commit *Commit) status(ctx context.Context, opts ...grpc.CallOption) (*Status, error) {
+	if err := commit.client.traceSign(ctx, commit.attributes, commit.isSigned, commit.sign); err != nil {
 		return nil, err
 	}
 
-	response, err := call(commit.signedRequest, opts...)
+	response, err := commit.client.CommitStatusWithContext(ctx, commit.signedRequest, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +116,16 @@ func (commit *Commit) setSignature(signature []byte) {
 	commit.signedRequest.Signature = signature
 }
 
+func (commit *Commit) attributes() []attribute.KeyValue {
+	request := &gateway.CommitStatusRequest{}
+	_ = proto.Unmarshal(commit.signedRequest.GetRequest(), request)
+
+	return []attribute.KeyValue{
+		attributeTransactionID.String(commit.transactionID),
+		attributeChannel.String(request.GetChannelId()),
+	}
+}
+
 // Status of a committed transaction.
 type Status struct {
 	Code          peer.TxValidationCode
@@ -125,3 +133,10 @@ type Status struct {
 	TransactionID string
 	BlockNumber   uint64
 }
+
+func (status *Status) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attributeValidationCode.String(status.Code.String()),
+		attributeBlockNumber.Int64(int64(status.BlockNumber)), //#nosec G115 -- Block numbers do not exceed int64
+	}
+}
@@ -5,6 +5,9 @@ package client
 
 import (
 	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Contract represents a smart contract, and allows applications to:
@@ -77,7 +80,7 @@ func (contract *Contract) Evaluate(transactionName string, options ...ProposalOp
 // method provides greater control over the transaction proposal content and the endorsing peers on which it is
 // evaluated. This allows transaction functions to be evaluated where the proposal must include transient data.
 func (contract *Contract) EvaluateWithContext(ctx context.Context, transactionName string, options ...ProposalOption) ([]byte, error) {
-	proposal, err := contract.NewProposal(transactionName, options...)
+	proposal, err := contract.newProposal(ctx, transactionName, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +201,7 @@ func (contract *Contract) SubmitAsync(transactionName string, options ...Proposa
 // The error may wrap an underlying [context.DeadlineExceeded] if the operation failed due to a timeout. The error can
 // be inspected with [errors.Is] or [errors.As].
 func (contract *Contract) SubmitAsyncWithContext(ctx context.Context, transactionName string, options ...ProposalOption) ([]byte, *Commit, error) {
-	proposal, err := contract.NewProposal(transactionName, options...)
+	proposal, err := contract.newProposal(ctx, transactionName, options...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -220,24 +223,45 @@ func (contract *Contract) SubmitAsyncWithContext(ctx context.Context, transactio
 
 // NewProposal creates a proposal that can be sent to peers for endorsement. Supports off-line signing transaction flow.
 func (contract *Contract) NewProposal(transactionName string, options ...ProposalOption) (*Proposal, error) {
-	builder, err := newProposalBuilder(
-		contract.client,
-		contract.signingID,
-		contract.channelName,
-		contract.chaincodeName,
-		contract.qualifiedTransactionName(transactionName),
-	)
-	if err != nil {
-		return nil, err
+	return contract.newProposal(contract.client.contexts.ctx, transactionName, options...)
+}
+
+func (contract *Contract) newProposal(ctx context.Context, transactionName string, options ...ProposalOption) (*Proposal, error) {
+	qualifiedTransactionName := contract.qualifiedTransactionName(transactionName)
+	attributes := func() []attribute.KeyValue {
+		return []attribute.KeyValue{
+			attributeChannel.String(contract.channelName),
+			attributeChaincode.String(contract.chaincodeName),
+			attributeTransactionName.String(qualifiedTransactionName),
+		}
 	}
 
-	for _, option := range options {
-		if err := option(builder); err != nil {
+	return withSpan(ctx, contract.client, "BuildProposal", trace.SpanKindInternal, attributes, func(context.Context) (*Proposal, error) {
+		builder, err := newProposalBuilder(
+			contract.client,
+			contract.signingID,
+			contract.channelName,
+			contract.chaincodeName,
+			qualifiedTransactionName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, option := range options {
+			if err := option(builder); err != nil {
+				return nil, err
+			}
+		}
+
+		proposal, err := builder.build()
+		if err != nil {
 			return nil, err
 		}
-	}
 
-	return builder.build()
+		contract.client.logProposalCreated(ctx, proposal, builder.transient)
+		return proposal, nil
+	}, (*Proposal).buildAttributes)
 }
 
 func (contract *Contract) qualifiedTransactionName(name string) string {
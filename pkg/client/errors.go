@@ -48,16 +48,9 @@ func (e *grpcError) Details() []*gateway.ErrorDetail {
 	return results
 }
 
-// TransactionError represents an error invoking a transaction. This is a gRPC [status] error.
-type TransactionError struct {
-	grpcError
-	TransactionID string
-}
-
-// Error message including attached details.
-func (e *TransactionError) Error() string {
+func (e *grpcError) errorWithDetails() string {
 	var result strings.Builder
-	result.WriteString(e.grpcError.Error())
+	result.WriteString(e.Error())
 
 	details := e.Details()
 	if len(details) == 0 {
@@ -77,6 +70,17 @@ func (e *TransactionError) Error() string {
 	return result.String()
 }
 
+// TransactionError represents an error invoking a transaction. This is a gRPC [status] error.
+type TransactionError struct {
+	grpcError
+	TransactionID string
+}
+
+// Error message including attached details.
+func (e *TransactionError) Error() string {
+	return e.errorWithDetails()
+}
+
 // EndorseError represents a failure endorsing a transaction proposal.
 type EndorseError struct {
 	*TransactionError
@@ -122,6 +126,22 @@ func (e *CommitStatusError) Unwrap() error {
 	return e.TransactionError
 }
 
+func newEventsError(err error) *EventsError {
+	return &EventsError{
+		grpcError: grpcError{err},
+	}
+}
+
+// EventsError represents a failure reading events. This is a gRPC [status] error.
+type EventsError struct {
+	grpcError
+}
+
+// Error message including attached details.
+func (e *EventsError) Error() string {
+	return fmt.Sprintf("events error: %s", e.errorWithDetails())
+}
+
 func newCommitError(transactionID string, code peer.TxValidationCode) error {
 	return &CommitError{
 		message:       fmt.Sprintf("transaction %s failed to commit with status code %d (%s)", transactionID, int32(code), peer.TxValidationCode_name[int32(code)]),
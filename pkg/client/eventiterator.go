@@ -0,0 +1,182 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+// eventReceiver returns the next events received from an event stream. A clean end of the stream is indicated by an
+// io.EOF error.
+type eventReceiver[T any] func() ([]T, error)
+
+// iterateEvents lazily connects to an event stream each time the returned sequence is iterated. The stream is closed
+// when iteration stops.
+func iterateEvents[T any](ctx context.Context, connect func(context.Context) (eventReceiver[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		receive, err := connect(ctx)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		for event, err := range receiveEvents(ctx, receive) {
+			if !yield(event, err) {
+				return
+			}
+		}
+	}
+}
+
+// receiveEvents returns a sequence of events read from a connected event stream. The sequence ends without error if
+// the stream ends cleanly or the context is done. Any other failure is returned as the final error in the sequence.
+func receiveEvents[T any](ctx context.Context, receive eventReceiver[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			events, err := receive()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					var zero T
+					yield(zero, err)
+				}
+				return
+			}
+
+			for _, event := range events {
+				if !yield(event, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// sendEvents connects to an event stream and forwards events to the returned channel. The channel is closed, and the
+// stream closed, when the stream ends for any reason.
+func sendEvents[T any](ctx context.Context, connect func(context.Context) (eventReceiver[T], error)) (<-chan T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	receive, err := connect(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	results := make(chan T)
+	go func() {
+		defer cancel()
+		defer close(results)
+
+		for event, err := range receiveEvents(ctx, receive) {
+			if err != nil {
+				return
+			}
+
+			select {
+			case results <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+// receiveUntilBlock wraps an event receiver to end cleanly once all events up to and including the end block have
+// been received.
+func receiveUntilBlock[T any](receive eventReceiver[T], endBlock uint64, blockNumber func(T) uint64) eventReceiver[T] {
+	done := false
+
+	return func() ([]T, error) {
+		if done {
+			return nil, io.EOF
+		}
+
+		events, err := receive()
+		if err != nil {
+			return nil, err
+		}
+
+		for i, event := range events {
+			if blockNumber(event) > endBlock {
+				done = true
+				return events[:i], nil
+			}
+		}
+
+		if len(events) > 0 && blockNumber(events[len(events)-1]) == endBlock {
+			done = true
+		}
+		return events, nil
+	}
+}
+
+type deliverEventsClient interface {
+	Recv() (*peer.DeliverResponse, error)
+}
+
+func newDeliverEventReceiver[T comparable](client deliverEventsClient, getEvent func(*peer.DeliverResponse) T) eventReceiver[T] {
+	return func() ([]T, error) {
+		response, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return nil, newEventsError(err)
+		}
+
+		if _, ok := response.GetType().(*peer.DeliverResponse_Status); ok {
+			if response.GetStatus() == common.Status_SUCCESS {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("unexpected deliver status: %s", response.GetStatus())
+		}
+
+		var zero T
+		event := getEvent(response)
+		if event == zero {
+			return nil, fmt.Errorf("unexpected deliver response type: %T", response.GetType())
+		}
+
+		return []T{event}, nil
+	}
+}
+
+// checkpointEvents checkpoints each event in a sequence once it has been successfully processed by the consumer,
+// indicated by the consumer requesting the next event. If no checkpointer is specified, the sequence is unchanged.
+func checkpointEvents[T any](
+	events iter.Seq2[T, error],
+	checkpointer Checkpointer,
+	checkpoint func(Checkpointer, T) error,
+) iter.Seq2[T, error] {
+	if checkpointer == nil {
+		return events
+	}
+
+	return func(yield func(T, error) bool) {
+		for event, err := range events {
+			if !yield(event, err) || err != nil {
+				return
+			}
+
+			if err := checkpoint(checkpointer, event); err != nil {
+				var zero T
+				yield(zero, fmt.Errorf("failed to checkpoint event: %w", err))
+				return
+			}
+		}
+	}
+}
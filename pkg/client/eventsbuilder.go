@@ -4,6 +4,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/fabric-protos-go-apiv2/orderer"
 )
 
@@ -12,7 +14,10 @@ type eventsBuilder struct {
 	signingID          *signingIdentity
 	channelName        string
 	startPosition      *orderer.SeekPosition
+	endBlock           *uint64
 	afterTransactionID string
+	reconnectPolicy    *ReconnectPolicy
+	checkpointer       Checkpointer
 }
 
 func (builder *eventsBuilder) getStartPosition() *orderer.SeekPosition {
@@ -27,6 +32,18 @@ func (builder *eventsBuilder) getStartPosition() *orderer.SeekPosition {
 	}
 }
 
+func (builder *eventsBuilder) validate() error {
+	if builder.endBlock == nil {
+		return nil
+	}
+
+	if start := builder.startPosition.GetSpecified(); start != nil && start.GetNumber() > *builder.endBlock {
+		return fmt.Errorf("start block %d is after end block %d", start.GetNumber(), *builder.endBlock)
+	}
+
+	return nil
+}
+
 type eventOption = func(builder *eventsBuilder) error
 
 // Checkpoint provides the current position for event processing.
@@ -51,6 +68,47 @@ func WithStartBlock(blockNumber uint64) eventOption {
 	}
 }
 
+// WithStartOldestBlock reads block events starting at the oldest block available on the Gateway peer. This can be used
+// to replay all blocks in the channel. This option is not supported for chaincode events.
+func WithStartOldestBlock() BlockEventsOption {
+	return func(builder *eventsBuilder) error {
+		builder.startPosition = &orderer.SeekPosition{
+			Type: &orderer.SeekPosition_Oldest{
+				Oldest: &orderer.SeekOldest{},
+			},
+		}
+		return nil
+	}
+}
+
+// WithStartNewestBlock reads block events starting at the newest block committed on the Gateway peer. Unlike the
+// default start position, which begins from the next block to be committed, the current block is included. This option
+// is not supported for chaincode events.
+func WithStartNewestBlock() BlockEventsOption {
+	return func(builder *eventsBuilder) error {
+		builder.startPosition = &orderer.SeekPosition{
+			Type: &orderer.SeekPosition_Newest{
+				Newest: &orderer.SeekNewest{},
+			},
+		}
+		return nil
+	}
+}
+
+// WithEndBlock stops reading events after the specified block number, allowing a bounded range of historical blocks to
+// be replayed. Eventing ends without error once all events up to and including the end block have been delivered. The
+// end block must not be earlier than the start block.
+//
+// For chaincode events, the end block is applied by the client. Since the Gateway only delivers blocks containing
+// matching chaincode events, eventing ends only when the end block is reached and either that block contains matching
+// events, or a matching event from a later block is received. The context should be used to impose a time limit.
+func WithEndBlock(blockNumber uint64) eventOption {
+	return func(builder *eventsBuilder) error {
+		builder.endBlock = &blockNumber
+		return nil
+	}
+}
+
 // WithCheckpoint reads events starting at the checkpoint position. This can be used to resume a previous eventing
 // session. The zero value is ignored and a start position specified by other options or the default position is used.
 func WithCheckpoint(checkpoint Checkpoint) eventOption {
@@ -74,3 +132,22 @@ func WithCheckpoint(checkpoint Checkpoint) eventOption {
 		return nil
 	}
 }
+
+// WithCheckpointer reads events starting at the checkpoint position, in the same way as [WithCheckpoint]. In addition,
+// events obtained using Iterate are checkpointed automatically once each event has been processed by the body of the
+// range loop, and iteration continues to the next event. An event processed in a loop iteration that ends iteration
+// early, for example using break, is not checkpointed. A failure to checkpoint an event is returned as the final error
+// in the sequence.
+//
+// Events obtained using the Events channel are not checkpointed automatically, and should instead be checkpointed by
+// the caller once processed.
+func WithCheckpointer(checkpointer Checkpointer) eventOption {
+	return func(builder *eventsBuilder) error {
+		if err := WithCheckpoint(checkpointer)(builder); err != nil {
+			return err
+		}
+
+		builder.checkpointer = checkpointer
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+// eventReconnecting records an event stream reconnect attempt.
+func (client *gatewayClient) eventReconnecting(ctx context.Context, operation Operation, channelName string) {
+	client.metrics.recordEventReconnect(operation, channelName)
+	client.logEventReconnect(ctx, operation, channelName)
+}
+
+// chaincodeEventsStream observes chaincode events received from the stream.
+type chaincodeEventsStream struct {
+	gateway.Gateway_ChaincodeEventsClient
+	tracked     *trackedStream
+	client      *gatewayClient
+	channelName string
+}
+
+func (stream *chaincodeEventsStream) Recv() (*gateway.ChaincodeEventsResponse, error) {
+	response, err := stream.Gateway_ChaincodeEventsClient.Recv()
+	if err != nil {
+		err = stream.tracked.receiveError(err)
+		stream.client.logEventStreamEnd(stream.tracked.ctx, OperationChaincodeEvents, stream.channelName, err)
+		stream.tracked.end()
+		return nil, err
+	}
+
+	stream.client.metrics.recordEventDelivered(OperationChaincodeEvents, stream.channelName, response.GetBlockNumber())
+	return response, nil
+}
+
+// deliverEventsStream observes blocks received from the stream.
+type deliverEventsStream struct {
+	deliverStream
+	tracked     *trackedStream
+	client      *gatewayClient
+	operation   Operation
+	channelName string
+}
+
+func (stream *deliverEventsStream) Recv() (*peer.DeliverResponse, error) {
+	response, err := stream.deliverStream.Recv()
+	if err != nil {
+		err = stream.tracked.receiveError(err)
+		stream.client.logEventStreamEnd(stream.tracked.ctx, stream.operation, stream.channelName, err)
+		stream.tracked.end()
+		return nil, err
+	}
+
+	if blockNumber, ok := deliverResponseBlockNumber(response); ok {
+		stream.client.metrics.recordEventDelivered(stream.operation, stream.channelName, blockNumber)
+	}
+	return response, nil
+}
+
+func deliverResponseBlockNumber(response *peer.DeliverResponse) (uint64, bool) {
+	switch {
+	case response.GetBlock() != nil:
+		return response.GetBlock().GetHeader().GetNumber(), true
+	case response.GetFilteredBlock() != nil:
+		return response.GetFilteredBlock().GetNumber(), true
+	case response.GetBlockAndPrivateData() != nil:
+		return response.GetBlockAndPrivateData().GetBlock().GetHeader().GetNumber(), true
+	default:
+		return 0, false
+	}
+}
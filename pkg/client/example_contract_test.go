@@ -113,6 +113,37 @@ func ExampleContract_Submit_privateData() {
 	fmt.Printf("Result: %s, Err: %v", result, err)
 }
 
+func ExampleEvaluateAs() {
+	var contract *client.Contract // Obtained from Network.
+
+	type Asset struct {
+		ID    string `json:"id"`
+		Owner string `json:"owner"`
+	}
+
+	asset, err := client.EvaluateAs[Asset](contract, client.JSONCodec, "ReadAsset", client.WithArguments("asset1"))
+
+	fmt.Printf("Asset: %+v, Err: %v\n", asset, err)
+}
+
+func ExampleSubmitAs() {
+	var contract *client.Contract // Obtained from Network.
+
+	type Asset struct {
+		ID    string `json:"id"`
+		Owner string `json:"owner"`
+	}
+
+	asset, err := client.SubmitAs[Asset](
+		contract,
+		client.JSONCodec,
+		"CreateAsset",
+		client.WithTypedArguments(client.JSONCodec, Asset{ID: "asset1", Owner: "Tomoko"}),
+	)
+
+	fmt.Printf("Asset: %+v, Err: %v\n", asset, err)
+}
+
 func ExampleContract_SubmitAsync() {
 	var contract *client.Contract // Obtained from Network.
 
@@ -202,3 +233,21 @@ func ExampleContract_offlineSign() {
 
 	fmt.Printf("Result: %s\n", signedTransaction.Result())
 }
+
+func ExampleProposal_Content() {
+	var gateway *client.Gateway
+	var proposalBytes []byte // Serialized proposal received for off-line signing.
+
+	proposal, err := gateway.NewProposal(proposalBytes)
+	panicOnError(err)
+
+	content, err := proposal.Content()
+	panicOnError(err)
+
+	// Check the proposal content before signing.
+	if content.ChaincodeName != "basic" || content.TransactionName != "TransferAsset" {
+		panic(fmt.Errorf("unexpected invocation of %s on chaincode %s", content.TransactionName, content.ChaincodeName))
+	}
+
+	fmt.Printf("Transaction ID: %s, Arguments: %q\n", content.TransactionID, content.Arguments)
+}
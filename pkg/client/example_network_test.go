@@ -5,9 +5,12 @@ package client_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"google.golang.org/grpc/status"
 )
 
 func ExampleNetwork_ChaincodeEvents() {
@@ -45,7 +48,7 @@ func ExampleNetwork_ChaincodeEvents_checkpoint() {
 
 			for event := range events {
 				// Process event
-				checkpointer.CheckpointChaincodeEvent(event)
+				panicOnError(checkpointer.CheckpointChaincodeEvent(event))
 			}
 
 			_ = ctx.Err() // Reason events channel closed
@@ -53,6 +56,119 @@ func ExampleNetwork_ChaincodeEvents_checkpoint() {
 	}
 }
 
+func ExampleChaincodeEventsRequest_Iterate() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := network.NewChaincodeEventsRequest("chaincodeName", client.WithStartBlock(101))
+	panicOnError(err)
+
+	for event, err := range request.Iterate(ctx) {
+		if err != nil {
+			var eventsErr *client.EventsError
+			if errors.As(err, &eventsErr) {
+				fmt.Printf("Eventing failed with status %v: %v\n", status.Code(err), eventsErr.Details())
+			}
+			break
+		}
+
+		fmt.Printf("Received event: %#v\n", event)
+		// Break when done reading.
+	}
+}
+
+func ExampleWithCheckpointer() {
+	var network *client.Network // Obtained from Gateway.
+
+	checkpointer, err := client.NewFileCheckpointer("checkpoint.json")
+	panicOnError(err)
+	defer checkpointer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := network.NewChaincodeEventsRequest(
+		"chaincodeName",
+		client.WithStartBlock(101), // Ignored if the checkpointer has checkpoint state
+		client.WithCheckpointer(checkpointer),
+	)
+	panicOnError(err)
+
+	for event, err := range request.Iterate(ctx) {
+		panicOnError(err)
+
+		// Process event. It is checkpointed when the next event is requested.
+		fmt.Printf("Received event: %#v\n", event)
+	}
+}
+
+func ExampleWithStartOldestBlock() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocks, err := network.BlockEvents(ctx, client.WithStartOldestBlock())
+	panicOnError(err)
+
+	for block := range blocks {
+		fmt.Printf("Received block: %d\n", block.GetHeader().GetNumber())
+		// Break and cancel the context when done reading.
+	}
+}
+
+func ExampleWithEndBlock() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := network.NewBlockEventsRequest(client.WithStartBlock(100), client.WithEndBlock(200))
+	panicOnError(err)
+
+	for block, err := range request.Iterate(ctx) {
+		panicOnError(err)
+		fmt.Printf("Received block: %d\n", block.GetHeader().GetNumber())
+	}
+
+	// All blocks from 100 to 200 have been processed.
+}
+
+func ExampleWithReconnect() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	request, err := network.NewBlockEventsRequest(
+		client.WithStartBlock(101),
+		client.WithReconnect(client.ReconnectPolicy{
+			MaxAttempts: 10,
+			Backoff: client.Backoff{
+				InitialDelay: time.Second,
+				MaxDelay:     time.Minute,
+				Jitter:       0.2,
+			},
+			OnReconnect: func(attempt int, cause error) {
+				fmt.Printf("Reconnect attempt %d after error: %v\n", attempt, cause)
+			},
+		}),
+	)
+	panicOnError(err)
+
+	for block, err := range request.Iterate(ctx) {
+		if err != nil {
+			fmt.Printf("Eventing failed: %v\n", err)
+			break
+		}
+
+		fmt.Printf("Received block number %d\n", block.GetHeader().GetNumber())
+		// Break when done reading.
+	}
+}
+
 func ExampleNetwork_BlockEvents() {
 	var network *client.Network // Obtained from Gateway
 
@@ -87,7 +203,7 @@ func ExampleNetwork_BlockEvents_checkpoint() {
 
 			for event := range events {
 				// Process then checkpoint block
-				checkpointer.CheckpointBlock(event.GetHeader().GetNumber())
+				panicOnError(checkpointer.CheckpointBlock(event.GetHeader().GetNumber()))
 			}
 
 			_ = ctx.Err() // Reason events channel closed
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+// WithClientConnections uses the supplied gRPC client connections to Fabric Gateway peers, which may belong to
+// different organizations. Requests are sent to the first connection until it becomes unavailable, at which point the
+// Gateway fails over to the next available connection. The client connections will not be closed when the Gateway is
+// closed.
+//
+// Evaluate, endorse and commit status requests, and the establishment of event streams, are retried using another
+// connection if a Gateway peer is unavailable. A submit request is never resent, since the transaction may already
+// have reached the ordering service, but is sent to a connection that is not known to be unavailable. If a submit
+// fails, the commit status of the transaction can be checked to determine whether it was successfully submitted.
+//
+// Connection health is determined from gRPC connectivity state when the supplied connections are *grpc.ClientConn
+// instances, and from Unavailable errors returned by Gateway peers.
+func WithClientConnections(clientConnections ...grpc.ClientConnInterface) ConnectOption {
+	return func(gw *Gateway) error {
+		if len(clientConnections) == 0 {
+			return errors.New("no client connections supplied")
+		}
+
+		endpoints := newEndpointSelector(clientConnections)
+		gw.client.grpcGatewayClient = &failoverGatewayClient{endpoints}
+		gw.client.grpcDeliverClient = &failoverDeliverClient{endpoints}
+		return nil
+	}
+}
+
+type endpoint struct {
+	connection grpc.ClientConnInterface
+	gateway    gateway.GatewayClient
+	deliver    peer.DeliverClient
+}
+
+// isHealthy returns false if the connection is known to be unable to send requests.
+func (e *endpoint) isHealthy() bool {
+	connection, ok := e.connection.(interface{ GetState() connectivity.State })
+	if !ok {
+		return true
+	}
+
+	state := connection.GetState()
+	return state != connectivity.TransientFailure && state != connectivity.Shutdown
+}
+
+// endpointSelector chooses between Gateway peer endpoints. The current endpoint is used until it fails, after which
+// the next endpoint becomes current.
+type endpointSelector struct {
+	lock      sync.Mutex
+	endpoints []*endpoint
+	current   int
+}
+
+func newEndpointSelector(clientConnections []grpc.ClientConnInterface) *endpointSelector {
+	endpoints := make([]*endpoint, 0, len(clientConnections))
+	for _, clientConnection := range clientConnections {
+		endpoints = append(endpoints, &endpoint{
+			connection: clientConnection,
+			gateway:    gateway.NewGatewayClient(clientConnection),
+			deliver:    peer.NewDeliverClient(clientConnection),
+		})
+	}
+
+	return &endpointSelector{
+		endpoints: endpoints,
+	}
+}
+
+// candidates returns endpoints in the order they should be tried, starting from the current endpoint. Endpoints known
+// to be unhealthy are placed last.
+func (selector *endpointSelector) candidates() []*endpoint {
+	selector.lock.Lock()
+	current := selector.current
+	selector.lock.Unlock()
+
+	healthy := make([]*endpoint, 0, len(selector.endpoints))
+	var unhealthy []*endpoint
+	for i := range selector.endpoints {
+		endpoint := selector.endpoints[(current+i)%len(selector.endpoints)]
+		if endpoint.isHealthy() {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// failed records that an endpoint is unavailable. If it is the current endpoint, the next endpoint becomes current.
+func (selector *endpointSelector) failed(failedEndpoint *endpoint) {
+	selector.lock.Lock()
+	defer selector.lock.Unlock()
+
+	if selector.endpoints[selector.current] == failedEndpoint {
+		selector.current = (selector.current + 1) % len(selector.endpoints)
+	}
+}
+
+// invokeWithFailover invokes a call using each candidate endpoint in turn until the call succeeds, or fails for a
+// reason other than the endpoint being unavailable.
+func invokeWithFailover[T any](ctx context.Context, selector *endpointSelector, call func(*endpoint) (T, error)) (T, error) {
+	var result T
+	var err error
+
+	for _, endpoint := range selector.candidates() {
+		result, err = call(endpoint)
+		if !isUnavailable(err) {
+			return result, err
+		}
+
+		selector.failed(endpoint)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return result, err
+}
+
+// invokeOnce invokes a call using only the first candidate endpoint.
+func invokeOnce[T any](selector *endpointSelector, call func(*endpoint) (T, error)) (T, error) {
+	endpoint := selector.candidates()[0]
+
+	result, err := call(endpoint)
+	if isUnavailable(err) {
+		selector.failed(endpoint)
+	}
+
+	return result, err
+}
+
+func isUnavailable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+type failoverGatewayClient struct {
+	endpoints *endpointSelector
+}
+
+func (client *failoverGatewayClient) Endorse(ctx context.Context, in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (*gateway.EndorseResponse, error) {
+		return endpoint.gateway.Endorse(ctx, in, opts...)
+	})
+}
+
+func (client *failoverGatewayClient) Submit(ctx context.Context, in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
+	return invokeOnce(client.endpoints, func(endpoint *endpoint) (*gateway.SubmitResponse, error) {
+		return endpoint.gateway.Submit(ctx, in, opts...)
+	})
+}
+
+func (client *failoverGatewayClient) CommitStatus(ctx context.Context, in *gateway.SignedCommitStatusRequest, opts ...grpc.CallOption) (*gateway.CommitStatusResponse, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (*gateway.CommitStatusResponse, error) {
+		return endpoint.gateway.CommitStatus(ctx, in, opts...)
+	})
+}
+
+func (client *failoverGatewayClient) Evaluate(ctx context.Context, in *gateway.EvaluateRequest, opts ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (*gateway.EvaluateResponse, error) {
+		return endpoint.gateway.Evaluate(ctx, in, opts...)
+	})
+}
+
+func (client *failoverGatewayClient) ChaincodeEvents(
+	ctx context.Context,
+	in *gateway.SignedChaincodeEventsRequest,
+	opts ...grpc.CallOption,
+) (grpc.ServerStreamingClient[gateway.ChaincodeEventsResponse], error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (grpc.ServerStreamingClient[gateway.ChaincodeEventsResponse], error) {
+		return endpoint.gateway.ChaincodeEvents(ctx, in, opts...)
+	})
+}
+
+type failoverDeliverClient struct {
+	endpoints *endpointSelector
+}
+
+func (client *failoverDeliverClient) Deliver(ctx context.Context, opts ...grpc.CallOption) (deliverStream, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (deliverStream, error) {
+		return endpoint.deliver.Deliver(ctx, opts...)
+	})
+}
+
+func (client *failoverDeliverClient) DeliverFiltered(ctx context.Context, opts ...grpc.CallOption) (deliverStream, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (deliverStream, error) {
+		return endpoint.deliver.DeliverFiltered(ctx, opts...)
+	})
+}
+
+func (client *failoverDeliverClient) DeliverWithPrivateData(ctx context.Context, opts ...grpc.CallOption) (deliverStream, error) {
+	return invokeWithFailover(ctx, client.endpoints, func(endpoint *endpoint) (deliverStream, error) {
+		return endpoint.deliver.DeliverWithPrivateData(ctx, opts...)
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+type stateClientConn struct {
+	*MockClientConnInterface
+	state connectivity.State
+}
+
+func (connection *stateClientConn) GetState() connectivity.State {
+	return connection.state
+}
+
+func TestFailover(t *testing.T) {
+	unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+
+	t.Run("Returns error if no client connections supplied", func(t *testing.T) {
+		_, err := Connect(TestCredentials.Identity(), WithSign(TestCredentials.Sign), WithClientConnections())
+
+		require.Error(t, err)
+	})
+
+	t.Run("Evaluate fails over to next connection if Gateway peer unavailable", func(t *testing.T) {
+		expected := []byte("TRANSACTION_RESULT")
+
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection1, WithInvokeError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection2, WithEvaluateResponse(expected)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		actual, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Subsequent requests use failover connection", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection1, WithInvokeError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection2, WithEvaluateResponse(nil)).Twice()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		for range 2 {
+			_, err := contract.EvaluateTransaction("transaction")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Does not fail over on errors other than unavailable", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")
+
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection1, WithInvokeError(expected)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Aborted, status.Code(err), "status code")
+	})
+
+	t.Run("Returns error if all connections unavailable", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection1, WithInvokeError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection2, WithInvokeError(unavailableErr)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+
+	t.Run("Skips connections in transient failure state", func(t *testing.T) {
+		expected := []byte("TRANSACTION_RESULT")
+
+		failedConnection := &stateClientConn{
+			MockClientConnInterface: NewMockClientConnInterface(t),
+			state:                   connectivity.TransientFailure,
+		}
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(expected)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(failedConnection, mockConnection))
+
+		actual, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Endorse and commit status fail over to next connection", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection1, WithInvokeError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection2, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection2).Once()
+		ExpectCommitStatus(mockConnection2, WithInvokeError(unavailableErr)).Once()
+		ExpectCommitStatus(mockConnection1, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Submit is not resent if Gateway peer unavailable", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection1, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection1, WithInvokeError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection2, WithEvaluateResponse(nil)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var submitErr *SubmitError
+		require.ErrorAs(t, err, &submitErr)
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+
+		_, err = contract.EvaluateTransaction("transaction")
+		require.NoError(t, err, "subsequent request uses next connection")
+	})
+
+	t.Run("Submit is sent to connection not known to be unavailable", func(t *testing.T) {
+		failedConnection := &stateClientConn{
+			MockClientConnInterface: NewMockClientConnInterface(t),
+			state:                   connectivity.TransientFailure,
+		}
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnections(failedConnection, mockConnection))
+
+		_, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Chaincode events fail over to next connection", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection1, WithNewStreamError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection2, WithNewStreamResult(mockStream)).Once()
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream).Maybe().Return(context.Canceled)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := network.ChaincodeEvents(ctx, "CHAINCODE")
+		require.NoError(t, err)
+	})
+
+	t.Run("Block events fail over to next connection", func(t *testing.T) {
+		mockConnection1 := NewMockClientConnInterface(t)
+		ExpectDeliver(mockConnection1, WithNewStreamError(unavailableErr)).Once()
+		mockConnection2 := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectDeliver(mockConnection2, WithNewStreamResult(mockStream)).Once()
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Maybe().Return(nil)
+		ExpectRecvMsg(mockStream).Maybe().Return(context.Canceled)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnections(mockConnection1, mockConnection2))
+
+		_, err := network.BlockEvents(ctx)
+		require.NoError(t, err)
+	})
+}
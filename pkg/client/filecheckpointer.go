@@ -4,51 +4,135 @@
 package client
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
 )
 
-// FileCheckpointer is a [Checkpoint] implementation backed by persistent file storage. It can be used to checkpoint
+const checkpointStateVersion = 1
+
+// FileCheckpointer is a [Checkpointer] implementation backed by persistent file storage. It can be used to checkpoint
 // progress after successfully processing events, allowing eventing to be resumed from this point.
 //
 // Instances should be created using the [NewFileCheckpointer] constructor function. [FileCheckpointer.Close] should
 // be called when the checkpointer is no longer needed to free resources.
 type FileCheckpointer struct {
-	file  *os.File
-	state *checkpointState
+	name   string
+	file   *os.File
+	atomic bool
+	state  *checkpointState
 }
 
 type checkpointState struct {
+	Version       int    `json:"version,omitempty"`
 	BlockNumber   uint64 `json:"blockNumber"`
 	TransactionID string `json:"transactionId"`
+	Checksum      string `json:"checksum,omitempty"`
 }
 
-// NewFileCheckpointer creates a properly initialized FileCheckpointer.
-func NewFileCheckpointer(name string) (*FileCheckpointer, error) {
-	file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0600) //#nosec G304 G703 -- Caller responsible for safe file name
-	if err != nil {
-		return nil, err
+func (state *checkpointState) checksum() string {
+	sum := sha256.Sum256([]byte(strconv.FormatUint(state.BlockNumber, 10) + ":" + state.TransactionID))
+	return hex.EncodeToString(sum[:])
+}
+
+func (state *checkpointState) verify() error {
+	if state.Version == 0 {
+		// State written by an earlier version has no checksum.
+		return nil
 	}
 
+	if state.Version > checkpointStateVersion {
+		return fmt.Errorf("unsupported checkpoint state version: %d", state.Version)
+	}
+
+	if state.Checksum != state.checksum() {
+		return errors.New("checkpoint state checksum mismatch")
+	}
+
+	return nil
+}
+
+func (state *checkpointState) bytes() ([]byte, error) {
+	state.Version = checkpointStateVersion
+	state.Checksum = state.checksum()
+	return json.Marshal(state)
+}
+
+// FileCheckpointerOption implements an option that can be used when creating a FileCheckpointer.
+type FileCheckpointerOption = func(checkpointer *FileCheckpointer) error
+
+// WithAtomicWrites persists each checkpoint atomically, so that a failure part way through writing the checkpoint
+// file, such as a process crash or power loss, cannot leave a partially written file. The new state is written and
+// synced to a temporary file, which then replaces the checkpoint file. The previous checkpoint file is retained with a
+// .bak suffix, and is used to recover if the checkpoint file is missing or corrupt when the checkpointer is created.
+func WithAtomicWrites() FileCheckpointerOption {
+	return func(checkpointer *FileCheckpointer) error {
+		checkpointer.atomic = true
+		return nil
+	}
+}
+
+// NewFileCheckpointer creates a properly initialized FileCheckpointer.
+func NewFileCheckpointer(name string, options ...FileCheckpointerOption) (*FileCheckpointer, error) {
 	checkpointer := &FileCheckpointer{
-		file:  file,
-		state: &checkpointState{},
+		name: name,
 	}
 
-	if fileInfo, err := file.Stat(); err == nil && fileInfo.Size() > 0 {
-		decoder := json.NewDecoder(file)
-		if err := decoder.Decode(checkpointer.state); err != nil {
+	for _, option := range options {
+		if err := option(checkpointer); err != nil {
 			return nil, err
 		}
 	}
 
+	var err error
+	if checkpointer.atomic {
+		checkpointer.state, err = readCheckpointStateWithBackup(name)
+	} else {
+		err = checkpointer.open()
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	if err := checkpointer.save(); err != nil {
+		checkpointer.Close()
 		return nil, err
 	}
 
 	return checkpointer, nil
 }
 
+func (c *FileCheckpointer) open() error {
+	file, err := os.OpenFile(c.name, os.O_RDWR|os.O_CREATE, 0600) //#nosec G304 G703 -- Caller responsible for safe file name
+	if err != nil {
+		return err
+	}
+
+	c.file = file
+	c.state = &checkpointState{}
+
+	if fileInfo, err := file.Stat(); err == nil && fileInfo.Size() > 0 {
+		decoder := json.NewDecoder(file)
+		if err := decoder.Decode(c.state); err != nil {
+			file.Close()
+			return err
+		}
+		if err := c.state.verify(); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CheckpointBlock records a successfully processed block.
 func (c *FileCheckpointer) CheckpointBlock(blockNumber uint64) error {
 	return c.CheckpointTransaction(blockNumber+1, "")
@@ -78,20 +162,33 @@ func (c *FileCheckpointer) TransactionID() string {
 
 // Close the checkpointer when it is no longer needed to free resources.
 func (c *FileCheckpointer) Close() error {
+	if c.file == nil {
+		return nil
+	}
+
 	return c.file.Close()
 }
 
-// Sync commits the current state to stable storage.
+// Sync commits the current state to stable storage. When using [WithAtomicWrites], each checkpoint is already
+// committed to stable storage and this method does nothing.
 func (c *FileCheckpointer) Sync() error {
+	if c.file == nil {
+		return nil
+	}
+
 	return c.file.Sync()
 }
 
 func (c *FileCheckpointer) save() error {
-	data, err := json.Marshal(c.state)
+	data, err := c.state.bytes()
 	if err != nil {
 		return err
 	}
 
+	if c.atomic {
+		return writeFileAtomic(c.name, data)
+	}
+
 	size, err := c.file.WriteAt(data, 0)
 	if err != nil {
 		return err
@@ -99,3 +196,108 @@ func (c *FileCheckpointer) save() error {
 
 	return c.file.Truncate(int64(size))
 }
+
+func backupFileName(name string) string {
+	return name + ".bak"
+}
+
+// readCheckpointStateWithBackup reads checkpoint state from the named file, falling back to the backup file if the
+// named file is missing or corrupt. Zero state is returned if neither file exists.
+func readCheckpointStateWithBackup(name string) (*checkpointState, error) {
+	state, err := readCheckpointState(name)
+	if err == nil {
+		return state, nil
+	}
+
+	backupState, backupErr := readCheckpointState(backupFileName(name))
+	if backupErr == nil {
+		return backupState, nil
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if !errors.Is(backupErr, fs.ErrNotExist) {
+		return nil, backupErr
+	}
+
+	return &checkpointState{}, nil
+}
+
+func readCheckpointState(name string) (*checkpointState, error) {
+	data, err := os.ReadFile(name) //#nosec G304 -- Caller responsible for safe file name
+	if err != nil {
+		return nil, err
+	}
+
+	state := &checkpointState{}
+	if len(data) == 0 {
+		return state, nil
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint file %s: %w", name, err)
+	}
+	if err := state.verify(); err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint file %s: %w", name, err)
+	}
+
+	return state, nil
+}
+
+// writeFileAtomic replaces the named file with the supplied data such that, following a failure at any point, either
+// the named file or its backup contains the complete previous data.
+func writeFileAtomic(name string, data []byte) error {
+	dir := filepath.Dir(name)
+
+	tempFile, err := os.CreateTemp(dir, filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempName := tempFile.Name()
+	defer os.Remove(tempName) // No-op once renamed
+
+	if err := writeAndSync(tempFile, data); err != nil {
+		return err
+	}
+
+	if err := os.Rename(name, backupFileName(name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := os.Rename(tempName, name); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+func writeAndSync(file *os.File, data []byte) error {
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// syncDir commits directory entry changes, such as file renames, to stable storage.
+func syncDir(name string) error {
+	if runtime.GOOS == "windows" {
+		// Directories cannot be synced on Windows.
+		return nil
+	}
+
+	dir, err := os.Open(name) //#nosec G304 -- Caller responsible for safe file name
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
@@ -86,4 +86,131 @@ func TestFileCheckpointer(t *testing.T) {
 
 		require.Error(t, err)
 	})
+
+	t.Run("error reading checkpoint with incorrect checksum", func(t *testing.T) {
+		fileName := NonExistentFileName(t, tempDir)
+		data := []byte(`{"version":1,"blockNumber":1,"transactionId":"TRANSACTION_ID","checksum":"BAD_CHECKSUM"}`)
+		require.NoError(t, os.WriteFile(fileName, data, 0600), "WriteFile")
+
+		_, err := NewFileCheckpointer(fileName)
+
+		require.ErrorContains(t, err, "checksum")
+	})
+
+	t.Run("reads checkpoint without version or checksum", func(t *testing.T) {
+		fileName := NonExistentFileName(t, tempDir)
+		data := []byte(`{"blockNumber":1,"transactionId":"TRANSACTION_ID"}`)
+		require.NoError(t, os.WriteFile(fileName, data, 0600), "WriteFile")
+
+		actual, err := NewFileCheckpointer(fileName)
+		require.NoError(t, err, "NewFileCheckpointer")
+		defer actual.Close()
+
+		require.Equal(t, uint64(1), actual.BlockNumber())
+		require.Equal(t, "TRANSACTION_ID", actual.TransactionID())
+	})
+}
+
+func TestFileCheckpointerAtomicWrites(t *testing.T) {
+	newCheckpointer := func(t *testing.T) (*FileCheckpointer, string) {
+		fileName := NonExistentFileName(t, t.TempDir())
+		checkpointer, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+		require.NoError(t, err)
+
+		return checkpointer, fileName
+	}
+
+	t.Run("state is persisted", func(t *testing.T) {
+		expected, fileName := newCheckpointer(t)
+		defer expected.Close()
+
+		err := expected.CheckpointTransaction(uint64(1), "TRANSACTION_ID")
+		require.NoError(t, err)
+
+		actual, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+		require.NoError(t, err, "NewFileCheckpointer")
+		defer actual.Close()
+
+		require.Equal(t, uint64(1), actual.BlockNumber())
+		require.Equal(t, "TRANSACTION_ID", actual.TransactionID())
+	})
+
+	t.Run("state is readable without atomic writes", func(t *testing.T) {
+		expected, fileName := newCheckpointer(t)
+		defer expected.Close()
+
+		err := expected.CheckpointTransaction(uint64(1), "TRANSACTION_ID")
+		require.NoError(t, err)
+
+		actual, err := NewFileCheckpointer(fileName)
+		require.NoError(t, err, "NewFileCheckpointer")
+		defer actual.Close()
+
+		require.Equal(t, uint64(1), actual.BlockNumber())
+		require.Equal(t, "TRANSACTION_ID", actual.TransactionID())
+	})
+
+	t.Run("no temporary files remain", func(t *testing.T) {
+		checkpointer, fileName := newCheckpointer(t)
+		defer checkpointer.Close()
+
+		require.NoError(t, checkpointer.CheckpointBlock(1))
+		require.NoError(t, checkpointer.CheckpointBlock(2))
+
+		entries, err := os.ReadDir(path.Dir(fileName))
+		require.NoError(t, err, "ReadDir")
+
+		var actual []string
+		for _, entry := range entries {
+			actual = append(actual, entry.Name())
+		}
+		require.ElementsMatch(t, []string{path.Base(fileName), path.Base(fileName) + ".bak"}, actual)
+	})
+
+	t.Run("recovers previous state from backup if checkpoint file is corrupt", func(t *testing.T) {
+		checkpointer, fileName := newCheckpointer(t)
+		defer checkpointer.Close()
+
+		require.NoError(t, checkpointer.CheckpointTransaction(1, "TRANSACTION_ID_1"))
+		require.NoError(t, checkpointer.CheckpointTransaction(2, "TRANSACTION_ID_2"))
+		require.NoError(t, os.WriteFile(fileName, []byte(`{"blockNumber":2,"transa`), 0600), "WriteFile")
+
+		actual, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+		require.NoError(t, err, "NewFileCheckpointer")
+		defer actual.Close()
+
+		require.Equal(t, uint64(1), actual.BlockNumber())
+		require.Equal(t, "TRANSACTION_ID_1", actual.TransactionID())
+	})
+
+	t.Run("recovers previous state from backup if checkpoint file is missing", func(t *testing.T) {
+		checkpointer, fileName := newCheckpointer(t)
+		defer checkpointer.Close()
+
+		require.NoError(t, checkpointer.CheckpointTransaction(1, "TRANSACTION_ID_1"))
+		require.NoError(t, os.Rename(fileName, fileName+".bak"), "Rename")
+
+		actual, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+		require.NoError(t, err, "NewFileCheckpointer")
+		defer actual.Close()
+
+		require.Equal(t, uint64(1), actual.BlockNumber())
+		require.Equal(t, "TRANSACTION_ID_1", actual.TransactionID())
+	})
+
+	t.Run("error if checkpoint file and backup are both corrupt", func(t *testing.T) {
+		fileName := NonExistentFileName(t, t.TempDir())
+		require.NoError(t, os.WriteFile(fileName, []byte("I AM NOT JSON DATA"), 0600), "WriteFile")
+		require.NoError(t, os.WriteFile(fileName+".bak", []byte("I AM NOT JSON DATA"), 0600), "WriteFile")
+
+		_, err := NewFileCheckpointer(fileName, WithAtomicWrites())
+
+		require.Error(t, err)
+	})
+
+	t.Run("error checkpointing to non-writable file location", func(t *testing.T) {
+		_, err := NewFileCheckpointer(path.Join(t.TempDir(), "NON_EXISTENT_DIR", "FILE"), WithAtomicWrites())
+
+		require.Error(t, err)
+	})
 }
@@ -41,6 +41,7 @@ func Connect(id identity.Identity, options ...ConnectOption) (*Gateway, error) {
 			contexts: &contextFactory{
 				ctx: ctx,
 			},
+			tracker: newOperationTracker(ctx),
 		},
 		cancel: cancel,
 	}
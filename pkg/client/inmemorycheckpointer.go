@@ -3,27 +3,30 @@
 
 package client
 
-// InMemoryCheckpointer is a non-persistent [Checkpoint] implementation. It can be used to checkpoint progress after
+// InMemoryCheckpointer is a non-persistent [Checkpointer] implementation. It can be used to checkpoint progress after
 // successfully processing events, allowing eventing to be resumed from this point.
 type InMemoryCheckpointer struct {
 	blockNumber   uint64
 	transactionID string
 }
 
-// CheckpointBlock records a successfully processed block.
-func (c *InMemoryCheckpointer) CheckpointBlock(blockNumber uint64) {
-	c.CheckpointTransaction(blockNumber+1, "")
+// CheckpointBlock records a successfully processed block. This implementation never returns an error.
+func (c *InMemoryCheckpointer) CheckpointBlock(blockNumber uint64) error {
+	return c.CheckpointTransaction(blockNumber+1, "")
 }
 
-// CheckpointTransaction records a successfully processed transaction within a given block.
-func (c *InMemoryCheckpointer) CheckpointTransaction(blockNumber uint64, transactionID string) {
+// CheckpointTransaction records a successfully processed transaction within a given block. This implementation never
+// returns an error.
+func (c *InMemoryCheckpointer) CheckpointTransaction(blockNumber uint64, transactionID string) error {
 	c.blockNumber = blockNumber
 	c.transactionID = transactionID
+	return nil
 }
 
-// CheckpointChaincodeEvent records a successfully processed chaincode event.
-func (c *InMemoryCheckpointer) CheckpointChaincodeEvent(event *ChaincodeEvent) {
-	c.CheckpointTransaction(event.BlockNumber, event.TransactionID)
+// CheckpointChaincodeEvent records a successfully processed chaincode event. This implementation never returns an
+// error.
+func (c *InMemoryCheckpointer) CheckpointChaincodeEvent(event *ChaincodeEvent) error {
+	return c.CheckpointTransaction(event.BlockNumber, event.TransactionID)
 }
 
 // BlockNumber in which the next event is expected.
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Operation identifies the type of a Gateway operation.
+type Operation string
+
+// Gateway operations that can be intercepted.
+const (
+	OperationEvaluate                  Operation = "Evaluate"
+	OperationEndorse                   Operation = "Endorse"
+	OperationSubmit                    Operation = "Submit"
+	OperationCommitStatus              Operation = "CommitStatus"
+	OperationChaincodeEvents           Operation = "ChaincodeEvents"
+	OperationBlockEvents               Operation = "BlockEvents"
+	OperationFilteredBlockEvents       Operation = "FilteredBlockEvents"
+	OperationBlockAndPrivateDataEvents Operation = "BlockAndPrivateDataEvents"
+)
+
+// OperationInfo describes a Gateway operation. Fields that are not relevant to the operation, or that are not included
+// in the request message, are empty. In particular, the chaincode and transaction name are not available for commit
+// status operations, and only the channel name is available for block event operations.
+type OperationInfo struct {
+	Operation       Operation
+	ChannelName     string
+	ChaincodeName   string
+	TransactionName string
+	TransactionID   string
+}
+
+// Invoker performs a Gateway operation, returning any error that occurs.
+type Invoker func(ctx context.Context) error
+
+// Interceptor wraps the invocation of a Gateway operation. The interceptor must call invoke to perform the operation,
+// and should return the error it returns, which is the outcome of the operation. The interceptor may derive a new
+// context to pass to invoke. To reject an operation, the interceptor can return an error without calling invoke.
+//
+// For event operations, the interceptor wraps opening of the event stream, not the receipt of events.
+type Interceptor func(ctx context.Context, info *OperationInfo, invoke Invoker) error
+
+// WithInterceptors adds interceptors that wrap each Gateway operation. Interceptors are called in the order they are
+// specified, so the first interceptor is the outermost. An intercepted operation includes any retry attempts.
+func WithInterceptors(interceptors ...Interceptor) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.interceptors = append(gw.client.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// intercept invokes a call wrapped by the supplied interceptors. The operation information is only created if there
+// are interceptors.
+func intercept[T any](
+	ctx context.Context,
+	interceptors []Interceptor,
+	newInfo func() *OperationInfo,
+	call func(context.Context) (T, error),
+) (T, error) {
+	if len(interceptors) == 0 {
+		return call(ctx)
+	}
+
+	var result T
+	invoked := false
+	invoke := func(ctx context.Context) error {
+		var err error
+		result, err = call(ctx)
+		invoked = true
+		return err
+	}
+
+	info := newInfo()
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoke
+		invoke = func(ctx context.Context) error {
+			return interceptor(ctx, info, next)
+		}
+	}
+
+	err := invoke(ctx)
+	if err == nil && !invoked {
+		err = errors.New("interceptor did not invoke " + string(info.Operation) + " operation")
+	}
+
+	return result, err
+}
+
+func newProposalOperationInfo(operation Operation, channelName string, transactionID string, signedProposal *peer.SignedProposal) func() *OperationInfo {
+	return func() *OperationInfo {
+		info := &OperationInfo{
+			Operation:     operation,
+			ChannelName:   channelName,
+			TransactionID: transactionID,
+		}
+
+		info.ChaincodeName, info.TransactionName = parseProposalChaincodeInvocation(signedProposal)
+
+		return info
+	}
+}
+
+func newSubmitOperationInfo(in *gateway.SubmitRequest) func() *OperationInfo {
+	return func() *OperationInfo {
+		info := &OperationInfo{
+			Operation:     OperationSubmit,
+			ChannelName:   in.GetChannelId(),
+			TransactionID: in.GetTransactionId(),
+		}
+
+		info.ChaincodeName, info.TransactionName = parseChaincodeInvocationFromEnvelope(in.GetPreparedTransaction())
+
+		return info
+	}
+}
+
+func newCommitStatusOperationInfo(in *gateway.SignedCommitStatusRequest) func() *OperationInfo {
+	return func() *OperationInfo {
+		request := &gateway.CommitStatusRequest{}
+		_ = proto.Unmarshal(in.GetRequest(), request)
+
+		return &OperationInfo{
+			Operation:     OperationCommitStatus,
+			ChannelName:   request.GetChannelId(),
+			TransactionID: request.GetTransactionId(),
+		}
+	}
+}
+
+func newChaincodeEventsOperationInfo(in *gateway.SignedChaincodeEventsRequest) func() *OperationInfo {
+	return func() *OperationInfo {
+		request := &gateway.ChaincodeEventsRequest{}
+		_ = proto.Unmarshal(in.GetRequest(), request)
+
+		return &OperationInfo{
+			Operation:     OperationChaincodeEvents,
+			ChannelName:   request.GetChannelId(),
+			ChaincodeName: request.GetChaincodeId(),
+		}
+	}
+}
+
+func newBlockEventsOperationInfo(operation Operation, in *common.Envelope) func() *OperationInfo {
+	return func() *OperationInfo {
+		info := &OperationInfo{
+			Operation: operation,
+		}
+
+		payload := &common.Payload{}
+		if proto.Unmarshal(in.GetPayload(), payload) == nil {
+			info.ChannelName, _ = parseChannelNameFromHeader(payload.GetHeader())
+		}
+
+		return info
+	}
+}
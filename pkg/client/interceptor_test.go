@@ -0,0 +1,286 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+type interceptorContextKey struct{}
+
+func captureOperationInfo(infos *[]*OperationInfo) Interceptor {
+	return func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+		*infos = append(*infos, info)
+		return invoke(ctx)
+	}
+}
+
+// newEndorseResponseForProposal creates an endorse response whose prepared transaction includes the chaincode
+// proposal payload from the supplied proposal.
+func newEndorseResponseForProposal(t *testing.T, proposal *Proposal) *gateway.EndorseResponse {
+	response := AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")
+
+	proposalProto := &peer.Proposal{}
+	AssertUnmarshal(t, proposal.proposedTransaction.GetProposal().GetProposalBytes(), proposalProto)
+
+	payload := &common.Payload{}
+	AssertUnmarshal(t, response.GetPreparedTransaction().GetPayload(), payload)
+	transaction := &peer.Transaction{}
+	AssertUnmarshal(t, payload.GetData(), transaction)
+	actionPayload := &peer.ChaincodeActionPayload{}
+	AssertUnmarshal(t, transaction.GetActions()[0].GetPayload(), actionPayload)
+
+	actionPayload.ChaincodeProposalPayload = proposalProto.GetPayload()
+	transaction.GetActions()[0].Payload = AssertMarshal(t, actionPayload)
+	payload.Data = AssertMarshal(t, transaction)
+	response.PreparedTransaction.Payload = AssertMarshal(t, payload)
+
+	return response
+}
+
+func TestInterceptors(t *testing.T) {
+	t.Run("Evaluate intercepted with operation info", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil))
+
+		var infos []*OperationInfo
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(captureOperationInfo(&infos)))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+
+		_, err = proposal.Evaluate()
+		require.NoError(t, err)
+
+		expected := []*OperationInfo{
+			{
+				Operation:       OperationEvaluate,
+				ChannelName:     contract.channelName,
+				ChaincodeName:   "CHAINCODE",
+				TransactionName: "TRANSACTION",
+				TransactionID:   proposal.TransactionID(),
+			},
+		}
+		require.Equal(t, expected, infos)
+	})
+
+	t.Run("Submit flow intercepted with operation info", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+
+		var infos []*OperationInfo
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(captureOperationInfo(&infos)))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponseForProposal(t, proposal)))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		transaction, err := proposal.Endorse()
+		require.NoError(t, err)
+		commit, err := transaction.Submit()
+		require.NoError(t, err)
+		_, err = commit.Status()
+		require.NoError(t, err)
+
+		transactionID := proposal.TransactionID()
+		expected := []*OperationInfo{
+			{
+				Operation:       OperationEndorse,
+				ChannelName:     contract.channelName,
+				ChaincodeName:   "CHAINCODE",
+				TransactionName: "TRANSACTION",
+				TransactionID:   transactionID,
+			},
+			{
+				Operation:       OperationSubmit,
+				ChannelName:     "network",
+				ChaincodeName:   "CHAINCODE",
+				TransactionName: "TRANSACTION",
+				TransactionID:   transactionID,
+			},
+			{
+				Operation:     OperationCommitStatus,
+				ChannelName:   "network",
+				TransactionID: transactionID,
+			},
+		}
+		require.Equal(t, expected, infos)
+	})
+
+	t.Run("Interceptor receives operation error", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Aborted, "ENDORSE_ERROR")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithInvokeError(expected))
+
+		var actual error
+		interceptor := func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+			actual = invoke(ctx)
+			return actual
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(interceptor))
+
+		_, err := contract.SubmitTransaction("TRANSACTION")
+		require.ErrorIs(t, err, expected)
+
+		var endorseErr *EndorseError
+		require.ErrorAs(t, actual, &endorseErr)
+	})
+
+	t.Run("Interceptor can reject operation", func(t *testing.T) {
+		expected := errors.New("REJECTED")
+		mockConnection := NewMockClientConnInterface(t)
+
+		interceptor := func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+			return expected
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(interceptor))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Error if interceptor does not invoke operation", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+
+		interceptor := func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+			return nil
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(interceptor))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+
+		require.ErrorContains(t, err, string(OperationEvaluate))
+	})
+
+	t.Run("Interceptors called in order specified", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil))
+
+		var calls []string
+		newInterceptor := func(name string) Interceptor {
+			return func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+				calls = append(calls, name+" before")
+				err := invoke(ctx)
+				calls = append(calls, name+" after")
+				return err
+			}
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection),
+			WithInterceptors(newInterceptor("first"), newInterceptor("second")), WithInterceptors(newInterceptor("third")))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		expected := []string{"first before", "second before", "third before", "third after", "second after", "first after"}
+		require.Equal(t, expected, calls)
+	})
+
+	t.Run("Context from interceptor used for operation", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, CaptureInvokeContext(contexts))
+
+		interceptor := func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+			return invoke(context.WithValue(ctx, interceptorContextKey{}, "VALUE"))
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithInterceptors(interceptor))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		require.Equal(t, "VALUE", (<-contexts).Value(interceptorContextKey{}))
+	})
+
+	t.Run("Chaincode events intercepted with operation info", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		mockStream := NewMockClientStream(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(mockStream))
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Maybe().Return(nil)
+		ExpectRecvMsg(mockStream).Maybe().Return(context.Canceled)
+
+		var infos []*OperationInfo
+		network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection), WithInterceptors(captureOperationInfo(&infos)))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, err := network.ChaincodeEvents(ctx, "CHAINCODE")
+		require.NoError(t, err)
+
+		expected := []*OperationInfo{
+			{
+				Operation:     OperationChaincodeEvents,
+				ChannelName:   "NETWORK",
+				ChaincodeName: "CHAINCODE",
+			},
+		}
+		require.Equal(t, expected, infos)
+	})
+
+	for operation, testCase := range map[Operation]struct {
+		expectDeliver func(*MockClientConnInterface, ...newStreamFunction) *MockClientConnInterface_NewStream_Call
+		events        func(context.Context, *Network) error
+	}{
+		OperationBlockEvents: {
+			expectDeliver: ExpectDeliver,
+			events: func(ctx context.Context, network *Network) error {
+				_, err := network.BlockEvents(ctx)
+				return err
+			},
+		},
+		OperationFilteredBlockEvents: {
+			expectDeliver: ExpectDeliverFiltered,
+			events: func(ctx context.Context, network *Network) error {
+				_, err := network.FilteredBlockEvents(ctx)
+				return err
+			},
+		},
+		OperationBlockAndPrivateDataEvents: {
+			expectDeliver: ExpectDeliverWithPrivateData,
+			events: func(ctx context.Context, network *Network) error {
+				_, err := network.BlockAndPrivateDataEvents(ctx)
+				return err
+			},
+		},
+	} {
+		t.Run(string(operation)+" intercepted with operation info", func(t *testing.T) {
+			mockConnection := NewMockClientConnInterface(t)
+			mockStream := NewMockClientStream(t)
+			testCase.expectDeliver(mockConnection, WithNewStreamResult(mockStream))
+			ExpectSendMsg(mockStream)
+			mockStream.EXPECT().CloseSend().Maybe().Return(nil)
+			ExpectRecvMsg(mockStream).Maybe().Return(context.Canceled)
+
+			var infos []*OperationInfo
+			network := AssertNewTestNetwork(t, "NETWORK", WithClientConnection(mockConnection), WithInterceptors(captureOperationInfo(&infos)))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			err := testCase.events(ctx, network)
+			require.NoError(t, err)
+
+			expected := []*OperationInfo{
+				{
+					Operation:   operation,
+					ChannelName: "NETWORK",
+				},
+			}
+			require.Equal(t, expected, infos)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const redacted = "[REDACTED]"
+
+// Log attribute keys.
+const (
+	logKeyOperation       = "operation"
+	logKeyTransactionID   = "transaction_id"
+	logKeyChannel         = "channel"
+	logKeyChaincode       = "chaincode"
+	logKeyTransactionName = "transaction_name"
+	logKeyTransient       = "transient"
+	logKeyValidationCode  = "validation_code"
+	logKeyBlockNumber     = "block_number"
+	logKeyError           = "error"
+)
+
+// WithLogger enables diagnostic logging using the supplied logger. The following are logged, with the transaction ID,
+// channel and chaincode included as structured attributes where they are known:
+//
+//   - Debug: proposal creation, and the start of each Gateway operation.
+//   - Info: successful completion of each Gateway operation, and the start and end of event streams.
+//   - Warn: transactions that fail validation, event stream failures, and event stream reconnects.
+//   - Error: failed Gateway operations and signing failures.
+//
+// Transient data values are always redacted, and only the transient data keys are logged. Proposal, transaction and
+// event payloads, signatures and signing credentials are never logged.
+func WithLogger(logger *slog.Logger) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.logger = logger
+		gw.client.interceptors = append(gw.client.interceptors, newLoggingInterceptor(logger))
+		return nil
+	}
+}
+
+func newLoggingInterceptor(logger *slog.Logger) Interceptor {
+	return func(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+		attrs := info.logAttrs()
+		if !isTransactionOperation(info.Operation) {
+			return logEventStreamStart(ctx, logger, attrs, invoke)
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "Operation started", attrs...)
+
+		if err := invoke(ctx); err != nil {
+			logger.LogAttrs(ctx, slog.LevelError, "Operation failed", append(attrs, slog.Any(logKeyError, err))...)
+			return err
+		}
+
+		logger.LogAttrs(ctx, slog.LevelInfo, "Operation completed", attrs...)
+		return nil
+	}
+}
+
+func logEventStreamStart(ctx context.Context, logger *slog.Logger, attrs []slog.Attr, invoke Invoker) error {
+	if err := invoke(ctx); err != nil {
+		logger.LogAttrs(ctx, slog.LevelError, "Event stream failed to start", append(attrs, slog.Any(logKeyError, err))...)
+		return err
+	}
+
+	logger.LogAttrs(ctx, slog.LevelInfo, "Event stream started", attrs...)
+	return nil
+}
+
+func (info *OperationInfo) logAttrs() []slog.Attr {
+	attrs := []slog.Attr{slog.String(logKeyOperation, string(info.Operation))}
+	attrs = appendNonEmptyAttr(attrs, logKeyTransactionID, info.TransactionID)
+	attrs = appendNonEmptyAttr(attrs, logKeyChannel, info.ChannelName)
+	attrs = appendNonEmptyAttr(attrs, logKeyChaincode, info.ChaincodeName)
+	attrs = appendNonEmptyAttr(attrs, logKeyTransactionName, info.TransactionName)
+	return attrs
+}
+
+func appendNonEmptyAttr(attrs []slog.Attr, key string, value string) []slog.Attr {
+	if value == "" {
+		return attrs
+	}
+	return append(attrs, slog.String(key, value))
+}
+
+// logAttrs converts span attributes to log attributes, so that transaction details are described consistently.
+func logAttrs(attributes []attribute.KeyValue) []slog.Attr {
+	results := make([]slog.Attr, 0, len(attributes))
+	for _, kv := range attributes {
+		key := strings.TrimPrefix(string(kv.Key), "fabric.")
+		results = append(results, slog.Any(key, kv.Value.AsInterface()))
+	}
+	return results
+}
+
+// logProposalCreated logs a newly created proposal. Transient data values are redacted.
+func (client *gatewayClient) logProposalCreated(ctx context.Context, proposal *Proposal, transient map[string][]byte) {
+	if client.logger == nil {
+		return
+	}
+
+	attrs := logAttrs(proposal.attributes())
+	if len(transient) > 0 {
+		attrs = append(attrs, slog.Any(logKeyTransient, redactedTransient(transient)))
+	}
+
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "Proposal created", attrs...)
+}
+
+// logSignFailure logs a failure to sign a message.
+func (client *gatewayClient) logSignFailure(ctx context.Context, attributes func() []attribute.KeyValue, err error) {
+	if client.logger == nil {
+		return
+	}
+
+	attrs := append(logAttrs(attributes()), slog.Any(logKeyError, err))
+	client.logger.LogAttrs(ctx, slog.LevelError, "Signing failed", attrs...)
+}
+
+// logCommitStatus logs a transaction that failed validation.
+func (client *gatewayClient) logCommitStatus(ctx context.Context, in *gateway.SignedCommitStatusRequest, response *gateway.CommitStatusResponse) {
+	if client.logger == nil || response.GetResult() == peer.TxValidationCode_VALID {
+		return
+	}
+
+	info := newCommitStatusOperationInfo(in)()
+	client.logger.LogAttrs(ctx, slog.LevelWarn, "Transaction failed validation",
+		slog.String(logKeyTransactionID, info.TransactionID),
+		slog.String(logKeyChannel, info.ChannelName),
+		slog.String(logKeyValidationCode, response.GetResult().String()),
+		slog.Uint64(logKeyBlockNumber, response.GetBlockNumber()),
+	)
+}
+
+// logEventReconnect logs an event stream reconnect attempt.
+func (client *gatewayClient) logEventReconnect(ctx context.Context, operation Operation, channelName string) {
+	if client.logger == nil {
+		return
+	}
+
+	client.logger.LogAttrs(ctx, slog.LevelWarn, "Reconnecting event stream",
+		slog.String(logKeyOperation, string(operation)),
+		slog.String(logKeyChannel, channelName),
+	)
+}
+
+// logEventStreamEnd logs the end of an event stream. A clean end or cancellation by the client is logged at info
+// level, and any other failure at warn level.
+func (client *gatewayClient) logEventStreamEnd(ctx context.Context, operation Operation, channelName string, err error) {
+	if client.logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String(logKeyOperation, string(operation)),
+		slog.String(logKeyChannel, channelName),
+	}
+
+	if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled || ctx.Err() != nil {
+		client.logger.LogAttrs(ctx, slog.LevelInfo, "Event stream ended", attrs...)
+		return
+	}
+
+	client.logger.LogAttrs(ctx, slog.LevelWarn, "Event stream failed", append(attrs, slog.Any(logKeyError, err))...)
+}
+
+// redactedTransient logs only the keys of transient data, with values redacted.
+type redactedTransient map[string][]byte
+
+func (transient redactedTransient) LogValue() slog.Value {
+	keys := make([]string, 0, len(transient))
+	for key := range transient {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, slog.String(key, redacted))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+type logRecords struct {
+	buffer bytes.Buffer
+}
+
+func (records *logRecords) logger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(&records.buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func (records *logRecords) all(t *testing.T) []map[string]any {
+	var results []map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(records.buffer.Bytes()))
+	for {
+		record := map[string]any{}
+		err := decoder.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			return results
+		}
+
+		require.NoError(t, err)
+		results = append(results, record)
+	}
+}
+
+func (records *logRecords) find(t *testing.T, message string, operation string) map[string]any {
+	for _, record := range records.all(t) {
+		if record[slog.MessageKey] == message && (operation == "" || record[logKeyOperation] == operation) {
+			return record
+		}
+	}
+
+	require.FailNow(t, "log record not found: "+message+" "+operation)
+	return nil
+}
+
+func TestLogging(t *testing.T) {
+	t.Run("Logs transaction lifecycle with transaction ID and channel", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		records := &logRecords{}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithLogger(records.logger()))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+		transaction, err := proposal.Endorse()
+		require.NoError(t, err)
+		commit, err := transaction.Submit()
+		require.NoError(t, err)
+		_, err = commit.Status()
+		require.NoError(t, err)
+
+		created := records.find(t, "Proposal created", "")
+		require.Equal(t, slog.LevelDebug.String(), created[slog.LevelKey])
+		require.Equal(t, proposal.TransactionID(), created[logKeyTransactionID])
+		require.Equal(t, "network", created[logKeyChannel])
+
+		for _, operation := range []Operation{OperationEndorse, OperationSubmit, OperationCommitStatus} {
+			started := records.find(t, "Operation started", string(operation))
+			require.Equal(t, slog.LevelDebug.String(), started[slog.LevelKey], operation)
+
+			completed := records.find(t, "Operation completed", string(operation))
+			require.Equal(t, slog.LevelInfo.String(), completed[slog.LevelKey], operation)
+			require.Equal(t, proposal.TransactionID(), completed[logKeyTransactionID], operation)
+			require.Equal(t, "network", completed[logKeyChannel], operation)
+		}
+	})
+
+	t.Run("Logs failed operation as error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")))
+
+		records := &logRecords{}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithLogger(records.logger()))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.Error(t, err)
+
+		record := records.find(t, "Operation failed", string(OperationEvaluate))
+		require.Equal(t, slog.LevelError.String(), record[slog.LevelKey])
+		require.Equal(t, "CHAINCODE", record[logKeyChaincode])
+		require.Contains(t, record[logKeyError], "EVALUATE_ERROR")
+	})
+
+	t.Run("Transient data values redacted", func(t *testing.T) {
+		records := &logRecords{}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithLogger(records.logger()))
+
+		transient := map[string][]byte{
+			"PRIVATE_KEY": []byte("PRIVATE_VALUE"),
+		}
+		_, err := contract.NewProposal("TRANSACTION", WithTransient(transient))
+		require.NoError(t, err)
+
+		require.NotContains(t, records.buffer.String(), "PRIVATE_VALUE")
+		record := records.find(t, "Proposal created", "")
+		require.Equal(t, map[string]any{"PRIVATE_KEY": redacted}, record[logKeyTransient])
+	})
+
+	t.Run("Logs signing failure", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		records := &logRecords{}
+		sign := func([]byte) ([]byte, error) {
+			return nil, errors.New("SIGN_ERROR")
+		}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithSign(sign), WithLogger(records.logger()))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+		_, err = proposal.Evaluate()
+		require.Error(t, err)
+
+		record := records.find(t, "Signing failed", "")
+		require.Equal(t, slog.LevelError.String(), record[slog.LevelKey])
+		require.Equal(t, proposal.TransactionID(), record[logKeyTransactionID])
+		require.Equal(t, "SIGN_ERROR", record[logKeyError])
+	})
+
+	t.Run("Logs transaction that failed validation as warning", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 101))
+
+		records := &logRecords{}
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithLogger(records.logger()))
+
+		_, commit, err := contract.SubmitAsync("TRANSACTION")
+		require.NoError(t, err)
+		_, err = commit.Status()
+		require.NoError(t, err)
+
+		record := records.find(t, "Transaction failed validation", "")
+		require.Equal(t, slog.LevelWarn.String(), record[slog.LevelKey])
+		require.Equal(t, commit.TransactionID(), record[logKeyTransactionID])
+		require.Equal(t, peer.TxValidationCode_MVCC_READ_CONFLICT.String(), record[logKeyValidationCode])
+	})
+
+	t.Run("Logs event stream start, reconnect and end", func(t *testing.T) {
+		unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+		newMockStream := func(t *testing.T, finalErr error) *MockClientStream {
+			mockStream := NewMockClientStream(t)
+			ExpectSendMsg(mockStream)
+			mockStream.EXPECT().CloseSend().Return(nil)
+			ExpectRecvMsg(mockStream, func(any) error {
+				return finalErr
+			})
+			return mockStream
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, unavailableErr))).Once()
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newMockStream(t, io.EOF))).Once()
+
+		records := &logRecords{}
+		network := AssertNewTestNetwork(t, "network", WithClientConnection(mockConnection), WithLogger(records.logger()))
+
+		policy := ReconnectPolicy{
+			Backoff: Backoff{
+				InitialDelay: time.Millisecond,
+			},
+		}
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithStartBlock(1), WithReconnect(policy))
+		require.NoError(t, err)
+		for _, err := range request.Iterate(t.Context()) {
+			require.NoError(t, err)
+		}
+
+		operation := string(OperationChaincodeEvents)
+		require.Equal(t, slog.LevelInfo.String(), records.find(t, "Event stream started", operation)[slog.LevelKey])
+		require.Equal(t, slog.LevelWarn.String(), records.find(t, "Event stream failed", operation)[slog.LevelKey])
+
+		reconnect := records.find(t, "Reconnecting event stream", operation)
+		require.Equal(t, slog.LevelWarn.String(), reconnect[slog.LevelKey])
+		require.Equal(t, "network", reconnect[logKeyChannel])
+
+		require.Equal(t, slog.LevelInfo.String(), records.find(t, "Event stream ended", operation)[slog.LevelKey])
+	})
+}
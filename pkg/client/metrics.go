@@ -0,0 +1,188 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metrics"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metricsNamespace = "fabric_gateway"
+	metricsSubsystem = "client"
+)
+
+var operationDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// WithMetrics records metrics for Gateway operations using instruments created by the supplied provider. Metric names
+// are prefixed with fabric_gateway_client. The following metrics are recorded:
+//
+//   - operations_total: counter of operations invoked, labeled by operation, channel and chaincode.
+//   - operation_duration_seconds: histogram of operation latency, labeled by operation, channel and chaincode.
+//   - operation_errors_total: counter of failed operations, labeled by operation, channel, chaincode and gRPC status
+//     code.
+//   - transactions_in_flight: gauge of in-progress evaluate, endorse, submit and commit status operations, labeled by
+//     operation.
+//   - transaction_commit_failures_total: counter of transactions that failed validation, labeled by channel and
+//     transaction validation code.
+//   - event_reconnects_total: counter of event stream reconnect attempts, labeled by operation and channel.
+//   - event_lag_blocks: gauge of the number of blocks between the latest block seen by this Gateway and the block
+//     most recently delivered by an event stream, labeled by operation and channel.
+//
+// For eventing operations, the operation metrics record the opening of the event stream.
+func WithMetrics(provider metrics.Provider) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.metrics = newClientMetrics(provider)
+		gw.client.interceptors = append(gw.client.interceptors, gw.client.metrics.intercept)
+		return nil
+	}
+}
+
+type clientMetrics struct {
+	operations        metrics.Counter
+	operationDuration metrics.Histogram
+	operationErrors   metrics.Counter
+	inFlight          metrics.Gauge
+	commitFailures    metrics.Counter
+	eventReconnects   metrics.Counter
+	eventLag          metrics.Gauge
+
+	lock         sync.Mutex
+	latestBlocks map[string]uint64
+}
+
+func newClientMetrics(provider metrics.Provider) *clientMetrics {
+	return &clientMetrics{
+		operations: provider.NewCounter(metrics.CounterOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "operations_total",
+			Help:       "The number of Gateway operations invoked.",
+			LabelNames: []string{"operation", "channel", "chaincode"},
+		}),
+		operationDuration: provider.NewHistogram(metrics.HistogramOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "operation_duration_seconds",
+			Help:       "The time taken to complete Gateway operations, in seconds.",
+			LabelNames: []string{"operation", "channel", "chaincode"},
+			Buckets:    operationDurationBuckets,
+		}),
+		operationErrors: provider.NewCounter(metrics.CounterOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "operation_errors_total",
+			Help:       "The number of Gateway operations that failed.",
+			LabelNames: []string{"operation", "channel", "chaincode", "code"},
+		}),
+		inFlight: provider.NewGauge(metrics.GaugeOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "transactions_in_flight",
+			Help:       "The number of transaction operations in progress.",
+			LabelNames: []string{"operation"},
+		}),
+		commitFailures: provider.NewCounter(metrics.CounterOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "transaction_commit_failures_total",
+			Help:       "The number of transactions that failed validation when committed.",
+			LabelNames: []string{"channel", "validation_code"},
+		}),
+		eventReconnects: provider.NewCounter(metrics.CounterOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "event_reconnects_total",
+			Help:       "The number of event stream reconnect attempts.",
+			LabelNames: []string{"operation", "channel"},
+		}),
+		eventLag: provider.NewGauge(metrics.GaugeOpts{
+			Namespace:  metricsNamespace,
+			Subsystem:  metricsSubsystem,
+			Name:       "event_lag_blocks",
+			Help:       "The number of blocks between the latest block seen and the block delivered by an event stream.",
+			LabelNames: []string{"operation", "channel"},
+		}),
+		latestBlocks: make(map[string]uint64),
+	}
+}
+
+func (m *clientMetrics) intercept(ctx context.Context, info *OperationInfo, invoke Invoker) error {
+	if isTransactionOperation(info.Operation) {
+		inFlight := m.inFlight.With(string(info.Operation))
+		inFlight.Add(1)
+		defer inFlight.Add(-1)
+	}
+
+	labels := []string{string(info.Operation), info.ChannelName, info.ChaincodeName}
+	m.operations.With(labels...).Add(1)
+
+	start := time.Now()
+	err := invoke(ctx)
+	m.operationDuration.With(labels...).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		m.operationErrors.With(append(labels, status.Code(err).String())...).Add(1)
+	}
+
+	return err
+}
+
+func isTransactionOperation(operation Operation) bool {
+	switch operation {
+	case OperationEvaluate, OperationEndorse, OperationSubmit, OperationCommitStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordCommitStatus records the transaction validation result, and the block number as seen for the channel.
+func (m *clientMetrics) recordCommitStatus(in *gateway.SignedCommitStatusRequest, response *gateway.CommitStatusResponse) {
+	if m == nil {
+		return
+	}
+
+	channelName := newCommitStatusOperationInfo(in)().ChannelName
+	m.blockSeen(channelName, response.GetBlockNumber())
+
+	if code := response.GetResult(); code != peer.TxValidationCode_VALID {
+		m.commitFailures.With(channelName, code.String()).Add(1)
+	}
+}
+
+// recordEventReconnect records an event stream reconnect attempt.
+func (m *clientMetrics) recordEventReconnect(operation Operation, channelName string) {
+	if m == nil {
+		return
+	}
+
+	m.eventReconnects.With(string(operation), channelName).Add(1)
+}
+
+// recordEventDelivered records delivery of an event for a specific block by an event stream.
+func (m *clientMetrics) recordEventDelivered(operation Operation, channelName string, blockNumber uint64) {
+	if m == nil {
+		return
+	}
+
+	latest := m.blockSeen(channelName, blockNumber)
+	m.eventLag.With(string(operation), channelName).Set(float64(latest - blockNumber))
+}
+
+// blockSeen updates the latest block seen for a channel, and returns the latest block number.
+func (m *clientMetrics) blockSeen(channelName string, blockNumber uint64) uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	latest := max(m.latestBlocks[channelName], blockNumber)
+	m.latestBlocks[channelName] = latest
+	return latest
+}
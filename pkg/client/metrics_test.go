@@ -0,0 +1,211 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metrics"
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func metricName(name string) string {
+	return metrics.FullyQualifiedName(metricsNamespace, metricsSubsystem, name)
+}
+
+func TestMetrics(t *testing.T) {
+	newChaincodeEventsStream := func(t *testing.T, finalErr error, responses ...*gateway.ChaincodeEventsResponse) *MockClientStream {
+		mockStream := NewMockClientStream(t)
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, func(message any) error {
+			if len(responses) == 0 {
+				return finalErr
+			}
+
+			proto.Merge(message.(proto.Message), responses[0])
+			responses = responses[1:]
+			return nil
+		})
+		return mockStream
+	}
+
+	t.Run("Counts operations by operation, channel and chaincode", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil))
+
+		provider := metrics.NewInMemoryProvider()
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+		_, err = contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		require.InDelta(t, 2, provider.Value(metricName("operations_total"), "Evaluate", "network", "CHAINCODE"), 0)
+		require.Len(t, provider.Observations(metricName("operation_duration_seconds"), "Evaluate", "network", "CHAINCODE"), 2)
+	})
+
+	t.Run("Counts errors by gRPC status code", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithInvokeError(NewStatusError(t, codes.Aborted, "ENDORSE_ERROR")))
+
+		provider := metrics.NewInMemoryProvider()
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := contract.SubmitTransaction("TRANSACTION")
+		require.Error(t, err)
+
+		require.InDelta(t, 1, provider.Value(metricName("operation_errors_total"), "Endorse", "network", "CHAINCODE", codes.Aborted.String()), 0)
+		require.InDelta(t, 1, provider.Value(metricName("operations_total"), "Endorse", "network", "CHAINCODE"), 0)
+	})
+
+	t.Run("Counts commit failures by validation code", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 101))
+
+		provider := metrics.NewInMemoryProvider()
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := contract.SubmitTransaction("TRANSACTION")
+		require.Error(t, err)
+
+		actual := provider.Value(metricName("transaction_commit_failures_total"), "network", peer.TxValidationCode_MVCC_READ_CONFLICT.String())
+		require.InDelta(t, 1, actual, 0)
+	})
+
+	t.Run("Successful commit not counted as failure", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 101))
+
+		provider := metrics.NewInMemoryProvider()
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := contract.SubmitTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		actual := provider.Value(metricName("transaction_commit_failures_total"), "network", peer.TxValidationCode_VALID.String())
+		require.Zero(t, actual)
+	})
+
+	t.Run("In-flight transactions incremented during operation", func(t *testing.T) {
+		provider := metrics.NewInMemoryProvider()
+		inFlight := make(chan float64, 1)
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, func(context.Context, string, any, any, ...grpc.CallOption) error {
+			inFlight <- provider.Value(metricName("transactions_in_flight"), "Evaluate")
+			return nil
+		})
+
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		require.InDelta(t, 1, <-inFlight, 0, "during operation")
+		require.Zero(t, provider.Value(metricName("transactions_in_flight"), "Evaluate"), "after operation")
+	})
+
+	t.Run("Event lag relative to latest block seen", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 10))
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newChaincodeEventsStream(t, io.EOF,
+			&gateway.ChaincodeEventsResponse{BlockNumber: 7, Events: []*peer.ChaincodeEvent{{ChaincodeId: "CHAINCODE"}}},
+		)))
+
+		provider := metrics.NewInMemoryProvider()
+		network := AssertNewTestNetwork(t, "network", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		_, err := network.GetContract("CHAINCODE").SubmitTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err)
+		for _, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+		}
+
+		require.InDelta(t, 3, provider.Value(metricName("event_lag_blocks"), "ChaincodeEvents", "network"), 0)
+	})
+
+	t.Run("Counts event stream reconnects", func(t *testing.T) {
+		unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newChaincodeEventsStream(t, unavailableErr))).Once()
+		ExpectChaincodeEvents(mockConnection, WithNewStreamResult(newChaincodeEventsStream(t, io.EOF))).Once()
+
+		provider := metrics.NewInMemoryProvider()
+		network := AssertNewTestNetwork(t, "network", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		policy := ReconnectPolicy{
+			Backoff: Backoff{
+				InitialDelay: time.Millisecond,
+			},
+		}
+		request, err := network.NewChaincodeEventsRequest("CHAINCODE", WithStartBlock(1), WithReconnect(policy))
+		require.NoError(t, err)
+		for _, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+		}
+
+		require.InDelta(t, 1, provider.Value(metricName("event_reconnects_total"), "ChaincodeEvents", "network"), 0)
+		require.InDelta(t, 2, provider.Value(metricName("operations_total"), "ChaincodeEvents", "network", "CHAINCODE"), 0)
+	})
+
+	t.Run("Does not count reconnect once end block is received", func(t *testing.T) {
+		unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+		requests := make(chan *common.Envelope, 1)
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectDeliver(mockConnection, WithNewStreamResult(newMockDeliverStream(t, requests, unavailableErr, &peer.DeliverResponse{
+			Type: &peer.DeliverResponse_Block{
+				Block: &common.Block{
+					Header: &common.BlockHeader{
+						Number: 5,
+					},
+				},
+			},
+		}))).Once()
+
+		provider := metrics.NewInMemoryProvider()
+		network := AssertNewTestNetwork(t, "network", WithClientConnection(mockConnection), WithMetrics(provider))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		policy := ReconnectPolicy{
+			Backoff: Backoff{
+				InitialDelay: time.Millisecond,
+			},
+		}
+		request, err := network.NewBlockEventsRequest(WithStartBlock(5), WithEndBlock(5), WithReconnect(policy))
+		require.NoError(t, err)
+		for _, err := range request.Iterate(ctx) {
+			require.NoError(t, err)
+		}
+
+		require.Zero(t, provider.Value(metricName("event_reconnects_total"), "BlockEvents", "network"))
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// MutualTLSCredentials are the PEM encoded credentials used to establish a mutual TLS connection to a Gateway peer.
+type MutualTLSCredentials struct {
+	// CACertificatesPEM are the CA certificates used to verify the Gateway peer's TLS certificate.
+	CACertificatesPEM []byte
+	// CertificatePEM is the client TLS certificate.
+	CertificatePEM []byte
+	// PrivateKeyPEM is the private key for the client TLS certificate.
+	PrivateKeyPEM []byte
+	// ServerNameOverride, if specified, is the host name used to verify the Gateway peer's TLS certificate instead of
+	// the host name in the target address.
+	ServerNameOverride string
+}
+
+// MutualTLSClientConnection is a gRPC client connection to a Gateway peer that uses mutual TLS. It should be passed to
+// [Connect] using [WithMutualTLSClientConnection] so that the matching client TLS certificate hash is used. The
+// connection must be closed when no longer needed.
+type MutualTLSClientConnection struct {
+	*grpc.ClientConn
+	certificateHash []byte
+}
+
+// NewMutualTLSClientConnection creates a gRPC client connection to the target Gateway peer address using mutual TLS.
+// An error is returned if the client TLS certificate and private key do not match. Additional dial options may be
+// supplied, but should not include transport credentials.
+func NewMutualTLSClientConnection(
+	target string,
+	mtlsCredentials MutualTLSCredentials,
+	options ...grpc.DialOption,
+) (*MutualTLSClientConnection, error) {
+	tlsConfig, err := mtlsCredentials.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	options = append([]grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, options...)
+	connection, err := grpc.NewClient(target, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC connection: %w", err)
+	}
+
+	certificateHash := sha256.Sum256(tlsConfig.Certificates[0].Certificate[0])
+	return &MutualTLSClientConnection{
+		ClientConn:      connection,
+		certificateHash: certificateHash[:],
+	}, nil
+}
+
+// TLSClientCertificateHash is the SHA-256 hash of the client TLS certificate used by the connection.
+func (connection *MutualTLSClientConnection) TLSClientCertificateHash() []byte {
+	return connection.certificateHash
+}
+
+// WithMutualTLSClientConnection uses the supplied mutual TLS gRPC client connection to a Fabric Gateway, and the
+// matching client TLS certificate hash. This should be used instead of [WithClientConnection] and
+// [WithTLSClientCertificateHash] to ensure the hash is correct for the client TLS certificate.
+func WithMutualTLSClientConnection(connection *MutualTLSClientConnection) ConnectOption {
+	return func(gw *Gateway) error {
+		if err := WithClientConnection(connection)(gw); err != nil {
+			return err
+		}
+		return WithTLSClientCertificateHash(connection.certificateHash)(gw)
+	}
+}
+
+func (mtlsCredentials *MutualTLSCredentials) tlsConfig() (*tls.Config, error) {
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(mtlsCredentials.CACertificatesPEM) {
+		return nil, errors.New("no valid CA certificates found in TLS CA certificates PEM")
+	}
+
+	certificate, err := tls.X509KeyPair(mtlsCredentials.CertificatePEM, mtlsCredentials.PrivateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client TLS certificate and private key: %w", err)
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{certificate},
+		ServerName:   mtlsCredentials.ServerNameOverride,
+	}, nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMutualTLSCredentials(t *testing.T) (MutualTLSCredentials, []byte) {
+	privateKey, err := test.NewECDSAPrivateKey()
+	require.NoError(t, err)
+
+	certificate, err := test.NewCertificate(privateKey)
+	require.NoError(t, err)
+
+	certificatePEM, err := identity.CertificateToPEM(certificate)
+	require.NoError(t, err)
+
+	privateKeyPEM, err := identity.PrivateKeyToPEM(privateKey)
+	require.NoError(t, err)
+
+	mtlsCredentials := MutualTLSCredentials{
+		CACertificatesPEM: certificatePEM,
+		CertificatePEM:    certificatePEM,
+		PrivateKeyPEM:     privateKeyPEM,
+	}
+	return mtlsCredentials, certificate.Raw
+}
+
+func TestMutualTLS(t *testing.T) {
+	t.Run("Connection TLS certificate hash is SHA-256 of client certificate", func(t *testing.T) {
+		mtlsCredentials, certificate := newTestMutualTLSCredentials(t)
+
+		connection, err := NewMutualTLSClientConnection("peer.example.org:7051", mtlsCredentials)
+		require.NoError(t, err)
+		defer connection.Close()
+
+		expected := sha256.Sum256(certificate)
+		require.Equal(t, expected[:], connection.TLSClientCertificateHash())
+	})
+
+	t.Run("Gateway uses connection TLS certificate hash", func(t *testing.T) {
+		mtlsCredentials, _ := newTestMutualTLSCredentials(t)
+
+		connection, err := NewMutualTLSClientConnection("peer.example.org:7051", mtlsCredentials)
+		require.NoError(t, err)
+		defer connection.Close()
+
+		gateway := AssertNewTestGateway(t, WithMutualTLSClientConnection(connection))
+
+		require.Equal(t, connection.TLSClientCertificateHash(), gateway.tlsCertificateHash)
+		require.NotNil(t, gateway.client.grpcGatewayClient)
+	})
+
+	t.Run("Fails if client certificate and private key do not match", func(t *testing.T) {
+		mtlsCredentials, _ := newTestMutualTLSCredentials(t)
+		otherCredentials, _ := newTestMutualTLSCredentials(t)
+		mtlsCredentials.PrivateKeyPEM = otherCredentials.PrivateKeyPEM
+
+		_, err := NewMutualTLSClientConnection("peer.example.org:7051", mtlsCredentials)
+		require.ErrorContains(t, err, "invalid client TLS certificate and private key")
+	})
+
+	t.Run("Fails for invalid CA certificates", func(t *testing.T) {
+		mtlsCredentials, _ := newTestMutualTLSCredentials(t)
+		mtlsCredentials.CACertificatesPEM = []byte("Non-PEM content")
+
+		_, err := NewMutualTLSClientConnection("peer.example.org:7051", mtlsCredentials)
+		require.ErrorContains(t, err, "CA certificates")
+	})
+}
@@ -50,6 +50,9 @@ func (network *Network) GetContractWithName(chaincodeName string, contractName s
 // position is used and the specified start block is ignored. If the checkpoint is unset then the start block is used.
 //
 // If no start position is specified, eventing begins from the next committed block.
+//
+// By default, the event stream ends if the connection to the Gateway peer fails. The [WithReconnect] option can be
+// used to automatically resume eventing following a connection failure.
 type ChaincodeEventsOption eventOption
 
 // ChaincodeEvents returns a channel from which chaincode events emitted by transaction functions in the specified
@@ -85,6 +88,16 @@ func (network *Network) NewChaincodeEventsRequest(chaincodeName string, options
 }
 
 // BlockEventsOption implements an option for a block events request.
+//
+// If both a start position and checkpoint are specified, and the checkpoint has a valid position set, the checkpoint
+// position is used and the specified start position is ignored. If the checkpoint is unset then the start position is
+// used.
+//
+// If no start position is specified, eventing begins from the next committed block. The [WithStartOldestBlock] and
+// [WithStartNewestBlock] options can be used to begin eventing from the oldest or newest block in the channel.
+//
+// By default, the event stream ends if the connection to the Gateway peer fails. The [WithReconnect] option can be
+// used to automatically resume eventing following a connection failure.
 type BlockEventsOption eventOption
 
 // BlockEvents returns a channel from which block events can be read.
@@ -8,6 +8,8 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
@@ -42,25 +44,21 @@ func (proposal *Proposal) TransactionID() string {
 
 // Endorse the proposal and obtain an endorsed transaction for submission to the orderer.
 func (proposal *Proposal) Endorse(opts ...grpc.CallOption) (*Transaction, error) {
-	return proposal.endorse(proposal.client.Endorse, opts...)
+	ctx, cancel := proposal.client.contexts.Endorse()
+	defer cancel()
+	return proposal.EndorseWithContext(ctx, opts...)
 }
 
 // EndorseWithContext uses ths supplied context to endorse the proposal and obtain an endorsed transaction for
 // submission to the orderer.
 func (proposal *Proposal) EndorseWithContext(ctx context.Context, opts ...grpc.CallOption) (*Transaction, error) {
-	return proposal.endorse(
-		func(in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error) {
-			return proposal.client.EndorseWithContext(ctx, in, opts...)
-		},
-		opts...,
-	)
+	return withSpan(ctx, proposal.client, "Endorse", trace.SpanKindClient, proposal.attributes, func(ctx context.Context) (*Transaction, error) {
+		return proposal.endorse(ctx, opts...)
+	}, nil)
 }
 
-func (proposal *Proposal) endorse(
-	call func(in *gateway.EndorseRequest, opts ...grpc.CallOption) (*gateway.EndorseResponse, error),
-	opts ...grpc.CallOption,
-) (*Transaction, error) {
-	if err := proposal.sign(); err != nil {
+func (proposal *Proposal) endorse(ctx context.Context, opts ...grpc.CallOption) (*Transaction, error) {
+	if err := proposal.client.traceSign(ctx, proposal.attributes, proposal.isSigned, proposal.sign); err != nil {
 		return nil, err
 	}
 
@@ -70,7 +68,7 @@ func (proposal *Proposal) endorse(
 		ProposedTransaction:    proposal.proposedTransaction.GetProposal(),
 		EndorsingOrganizations: proposal.proposedTransaction.GetEndorsingOrganizations(),
 	}
-	response, err := call(endorseRequest, opts...)
+	response, err := proposal.client.EndorseWithContext(ctx, endorseRequest, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,25 +82,21 @@ func (proposal *Proposal) endorse(
 
 // Evaluate the proposal and obtain a transaction result. This is effectively a query.
 func (proposal *Proposal) Evaluate(opts ...grpc.CallOption) ([]byte, error) {
-	return proposal.evaluate(proposal.client.Evaluate, opts...)
+	ctx, cancel := proposal.client.contexts.Evaluate()
+	defer cancel()
+	return proposal.EvaluateWithContext(ctx, opts...)
 }
 
 // EvaluateWithContext uses ths supplied context to evaluate the proposal and obtain a transaction result. This is
 // effectively a query.
 func (proposal *Proposal) EvaluateWithContext(ctx context.Context, opts ...grpc.CallOption) ([]byte, error) {
-	return proposal.evaluate(
-		func(in *gateway.EvaluateRequest, opts ...grpc.CallOption) (*gateway.EvaluateResponse, error) {
-			return proposal.client.EvaluateWithContext(ctx, in, opts...)
-		},
-		opts...,
-	)
+	return withSpan(ctx, proposal.client, "Evaluate", trace.SpanKindClient, proposal.attributes, func(ctx context.Context) ([]byte, error) {
+		return proposal.evaluate(ctx, opts...)
+	}, nil)
 }
 
-func (proposal *Proposal) evaluate(
-	call func(in *gateway.EvaluateRequest, opts ...grpc.CallOption) (*gateway.EvaluateResponse, error),
-	opts ...grpc.CallOption,
-) ([]byte, error) {
-	if err := proposal.sign(); err != nil {
+func (proposal *Proposal) evaluate(ctx context.Context, opts ...grpc.CallOption) ([]byte, error) {
+	if err := proposal.client.traceSign(ctx, proposal.attributes, proposal.isSigned, proposal.sign); err != nil {
 		return nil, err
 	}
 
@@ -112,7 +106,7 @@ func (proposal *Proposal) evaluate(
 		ProposedTransaction: proposal.proposedTransaction.GetProposal(),
 		TargetOrganizations: proposal.proposedTransaction.GetEndorsingOrganizations(),
 	}
-	response, err := call(evaluateRequest, opts...)
+	response, err := proposal.client.EvaluateWithContext(ctx, evaluateRequest, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,3 +137,22 @@ func (proposal *Proposal) sign() error {
 
 	return nil
 }
+
+func (proposal *Proposal) attributes() []attribute.KeyValue {
+	chaincodeName, transactionName := parseProposalChaincodeInvocation(proposal.proposedTransaction.GetProposal())
+	return []attribute.KeyValue{
+		attributeTransactionID.String(proposal.TransactionID()),
+		attributeChannel.String(proposal.channelID),
+		attributeChaincode.String(chaincodeName),
+		attributeTransactionName.String(transactionName),
+		attributeEndorsingOrganizations.StringSlice(proposal.proposedTransaction.GetEndorsingOrganizations()),
+	}
+}
+
+// buildAttributes are span attributes that are only known once the proposal is built.
+func (proposal *Proposal) buildAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attributeTransactionID.String(proposal.TransactionID()),
+		attributeEndorsingOrganizations.StringSlice(proposal.proposedTransaction.GetEndorsingOrganizations()),
+	}
+}
@@ -4,6 +4,7 @@
 package client
 
 import (
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
 	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
@@ -12,15 +13,16 @@ import (
 )
 
 type proposalBuilder struct {
-	client          *gatewayClient
-	signingID       *signingIdentity
-	channelName     string
-	chaincodeName   string
-	transactionName string
-	transactionCtx  *transactionContext
-	transient       map[string][]byte
-	endorsingOrgs   []string
-	args            [][]byte
+	client            *gatewayClient
+	signingID         *signingIdentity
+	channelName       string
+	chaincodeName     string
+	transactionName   string
+	transactionCtx    *transactionContext
+	transient         map[string][]byte
+	endorsingOrgs     []string
+	args              [][]byte
+	chaincodeMetadata *metadata.Metadata
 }
 
 func newProposalBuilder(
@@ -47,6 +49,10 @@ func newProposalBuilder(
 }
 
 func (builder *proposalBuilder) build() (*Proposal, error) {
+	if err := builder.validateArguments(); err != nil {
+		return nil, err
+	}
+
 	proposalBytes, err := builder.proposalBytes()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProposalContent is the content of a transaction proposal, parsed from the serialized proposal message that is
+// signed. Off-line signers can use it to check what they are signing before they generate a signature.
+type ProposalContent struct {
+	TransactionID string
+	ChannelName   string
+	ChaincodeName string
+	// TransactionName is the transaction function name, which may be qualified with a contract name.
+	TransactionName string
+	// Arguments are the transaction function arguments, excluding the transaction function name.
+	Arguments [][]byte
+	// TransientKeys are the keys of any transient data, in sorted order. Transient data values are not included.
+	TransientKeys []string
+	// CreatorMSPID is the member services provider ID of the client identity that created the proposal.
+	CreatorMSPID string
+	// CreatorCredentials are the credentials of the client identity that created the proposal, such as a PEM encoded
+	// X.509 certificate.
+	CreatorCredentials []byte
+	Nonce              []byte
+	Timestamp          time.Time
+	// EndorsingOrganizations are the organizations requested to endorse the proposal, or empty if the Gateway peer
+	// selects the endorsing organizations. This is not part of the signed proposal message.
+	EndorsingOrganizations []string
+}
+
+// Content parses the serialized proposal message, which is signed, to obtain the proposal content.
+func (proposal *Proposal) Content() (*ProposalContent, error) {
+	peerProposal := &peer.Proposal{}
+	if err := proto.Unmarshal(proposal.proposedTransaction.GetProposal().GetProposalBytes(), peerProposal); err != nil {
+		return nil, fmt.Errorf("failed to deserialize proposal: %w", err)
+	}
+
+	result := &ProposalContent{
+		EndorsingOrganizations: proposal.proposedTransaction.GetEndorsingOrganizations(),
+	}
+
+	if err := result.parseHeader(peerProposal.GetHeader()); err != nil {
+		return nil, err
+	}
+	if err := result.parsePayload(peerProposal.GetPayload()); err != nil {
+		return nil, err
+	}
+
+	if result.TransactionID != proposal.TransactionID() {
+		return nil, fmt.Errorf("proposal transaction ID %s does not match signed transaction ID %s",
+			proposal.TransactionID(), result.TransactionID)
+	}
+
+	return result, nil
+}
+
+func (content *ProposalContent) parseHeader(headerBytes []byte) error {
+	header := &common.Header{}
+	if err := proto.Unmarshal(headerBytes, header); err != nil {
+		return fmt.Errorf("failed to deserialize header: %w", err)
+	}
+
+	channelHeader := &common.ChannelHeader{}
+	if err := proto.Unmarshal(header.GetChannelHeader(), channelHeader); err != nil {
+		return fmt.Errorf("failed to deserialize channel header: %w", err)
+	}
+
+	signatureHeader := &common.SignatureHeader{}
+	if err := proto.Unmarshal(header.GetSignatureHeader(), signatureHeader); err != nil {
+		return fmt.Errorf("failed to deserialize signature header: %w", err)
+	}
+
+	creator := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(signatureHeader.GetCreator(), creator); err != nil {
+		return fmt.Errorf("failed to deserialize creator identity: %w", err)
+	}
+
+	content.TransactionID = channelHeader.GetTxId()
+	content.ChannelName = channelHeader.GetChannelId()
+	content.Timestamp = channelHeader.GetTimestamp().AsTime()
+	content.Nonce = signatureHeader.GetNonce()
+	content.CreatorMSPID = creator.GetMspid()
+	content.CreatorCredentials = creator.GetIdBytes()
+
+	return nil
+}
+
+func (content *ProposalContent) parsePayload(payloadBytes []byte) error {
+	payload := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(payloadBytes, payload); err != nil {
+		return fmt.Errorf("failed to deserialize chaincode proposal payload: %w", err)
+	}
+
+	invocationSpec := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(payload.GetInput(), invocationSpec); err != nil {
+		return fmt.Errorf("failed to deserialize chaincode invocation spec: %w", err)
+	}
+
+	chaincodeSpec := invocationSpec.GetChaincodeSpec()
+	content.ChaincodeName = chaincodeSpec.GetChaincodeId().GetName()
+
+	if args := chaincodeSpec.GetInput().GetArgs(); len(args) > 0 {
+		content.TransactionName = string(args[0])
+		content.Arguments = args[1:]
+	}
+
+	for key := range payload.GetTransientMap() {
+		content.TransientKeys = append(content.TransientKeys, key)
+	}
+	slices.Sort(content.TransientKeys)
+
+	return nil
+}
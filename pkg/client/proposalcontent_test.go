@@ -0,0 +1,100 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalContent(t *testing.T) {
+	newProposal := func(t *testing.T) *Proposal {
+		contract := AssertNewTestNetwork(t, "CHANNEL").GetContractWithName("CHAINCODE", "CONTRACT")
+		proposal, err := contract.NewProposal(
+			"TRANSACTION",
+			WithArguments("ONE", "TWO"),
+			WithTransient(map[string][]byte{"KEY_B": []byte("VALUE_B"), "KEY_A": []byte("VALUE_A")}),
+			WithEndorsingOrganizations("Org1MSP", "Org2MSP"),
+		)
+		require.NoError(t, err)
+		return proposal
+	}
+
+	t.Run("Includes invocation details", func(t *testing.T) {
+		proposal := newProposal(t)
+
+		actual, err := proposal.Content()
+		require.NoError(t, err)
+
+		require.Equal(t, proposal.TransactionID(), actual.TransactionID, "TransactionID")
+		require.Equal(t, "CHANNEL", actual.ChannelName, "ChannelName")
+		require.Equal(t, "CHAINCODE", actual.ChaincodeName, "ChaincodeName")
+		require.Equal(t, "CONTRACT:TRANSACTION", actual.TransactionName, "TransactionName")
+		require.Equal(t, [][]byte{[]byte("ONE"), []byte("TWO")}, actual.Arguments, "Arguments")
+		require.Equal(t, []string{"KEY_A", "KEY_B"}, actual.TransientKeys, "TransientKeys")
+		require.Equal(t, []string{"Org1MSP", "Org2MSP"}, actual.EndorsingOrganizations, "EndorsingOrganizations")
+	})
+
+	t.Run("Includes creator and signature header details", func(t *testing.T) {
+		before := time.Now().Add(-time.Second)
+		proposal := newProposal(t)
+
+		actual, err := proposal.Content()
+		require.NoError(t, err)
+
+		id := TestCredentials.Identity()
+		require.Equal(t, id.MspID(), actual.CreatorMSPID, "CreatorMSPID")
+		require.Equal(t, id.Credentials(), actual.CreatorCredentials, "CreatorCredentials")
+		require.NotEmpty(t, actual.Nonce, "Nonce")
+		require.WithinRange(t, actual.Timestamp, before, time.Now(), "Timestamp")
+	})
+
+	t.Run("Proposal without arguments or transient data", func(t *testing.T) {
+		proposal, err := AssertNewTestContract(t, "CHAINCODE").NewProposal("TRANSACTION")
+		require.NoError(t, err)
+
+		actual, err := proposal.Content()
+		require.NoError(t, err)
+
+		require.Equal(t, "TRANSACTION", actual.TransactionName)
+		require.Empty(t, actual.Arguments, "Arguments")
+		require.Empty(t, actual.TransientKeys, "TransientKeys")
+		require.Empty(t, actual.EndorsingOrganizations, "EndorsingOrganizations")
+	})
+
+	t.Run("Recreated proposal has same content", func(t *testing.T) {
+		proposal := newProposal(t)
+		expected, err := proposal.Content()
+		require.NoError(t, err)
+
+		proposalBytes, err := proposal.Bytes()
+		require.NoError(t, err)
+
+		recreated, err := AssertNewTestGateway(t).NewProposal(proposalBytes)
+		require.NoError(t, err)
+
+		actual, err := recreated.Content()
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Fails if transaction ID does not match signed proposal", func(t *testing.T) {
+		proposal := newProposal(t)
+		proposal.proposedTransaction.TransactionId = "MISMATCHED_TRANSACTION_ID"
+
+		_, err := proposal.Content()
+		require.ErrorContains(t, err, "MISMATCHED_TRANSACTION_ID")
+	})
+
+	t.Run("Fails for invalid proposal bytes", func(t *testing.T) {
+		proposal := newProposal(t)
+		proposal.proposedTransaction.Proposal.ProposalBytes = []byte("NOT_A_PROPOSAL")
+
+		_, err := proposal.Content()
+		require.Error(t, err)
+	})
+}
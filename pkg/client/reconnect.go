@@ -0,0 +1,148 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// ReconnectPolicy specifies how an event stream is re-established following a transport failure.
+type ReconnectPolicy struct {
+	// MaxAttempts is the maximum number of consecutive reconnect attempts without receiving an event. Zero allows
+	// unlimited attempts.
+	MaxAttempts int
+	// Backoff applied between reconnect attempts.
+	Backoff Backoff
+	// OnReconnect, if set, is notified before each reconnect attempt with the attempt number, starting at 1, and the
+	// error that caused the event stream to fail.
+	OnReconnect func(attempt int, cause error)
+}
+
+func (policy *ReconnectPolicy) shouldReconnect(ctx context.Context, err error, attempts int) bool {
+	if ctx.Err() != nil || !isReconnectable(err) {
+		return false
+	}
+
+	return policy.MaxAttempts <= 0 || attempts < policy.MaxAttempts
+}
+
+// isReconnectable returns true if the error indicates the event stream was lost due to a transport failure.
+func isReconnectable(err error) bool {
+	return isUnavailable(err)
+}
+
+// WithReconnect automatically re-establishes the event stream if it fails due to the Gateway peer becoming
+// unavailable. Each reconnect uses a newly signed request that resumes eventing immediately after the last event
+// delivered, so events are neither duplicated nor missed across reconnects. For block events, eventing resumes from
+// the block following the last block delivered. Until an event has been delivered, the start position specified by
+// other options is used. To avoid missing events in this case, a specific start block or checkpoint should be
+// specified.
+//
+// Since each reconnect requires a new signature, this option requires a signing implementation to be supplied when
+// connecting the Gateway, and cannot be used with off-line signing.
+func WithReconnect(policy ReconnectPolicy) eventOption {
+	return func(builder *eventsBuilder) error {
+		builder.reconnectPolicy = &policy
+		return nil
+	}
+}
+
+// connectWithReconnect connects to an event stream, reconnecting as allowed by the reconnect policy if the stream
+// fails. The last event received is checkpointed so that reconnects resume eventing immediately after it. If isComplete
+// is not nil and reports that all requested events were received before the stream failed, the events end without
+// reconnecting.
+func connectWithReconnect[T any](
+	ctx context.Context,
+	policy *ReconnectPolicy,
+	connect func(context.Context) (eventReceiver[T], error),
+	reconnect func(context.Context, Checkpoint) (eventReceiver[T], error),
+	checkpoint func(*InMemoryCheckpointer, T) error,
+	isComplete func(Checkpoint) bool,
+) (eventReceiver[T], error) {
+	receive, err := connect(ctx)
+	if err != nil {
+		if !isReconnectable(err) {
+			return nil, err
+		}
+		receive = failedEventReceiver[T](err)
+	}
+
+	checkpointer := new(InMemoryCheckpointer)
+	receive = reconnectingEventReceiver(
+		ctx,
+		policy,
+		receive,
+		func(ctx context.Context) (eventReceiver[T], error) {
+			return reconnect(ctx, checkpointer)
+		},
+		func() bool {
+			return isComplete != nil && isComplete(checkpointer)
+		},
+	)
+
+	result := func() ([]T, error) {
+		events, err := receive()
+		if err != nil || len(events) == 0 {
+			return events, err
+		}
+
+		if err := checkpoint(checkpointer, events[len(events)-1]); err != nil {
+			return nil, err
+		}
+		return events, nil
+	}
+	return result, nil
+}
+
+// reconnectingEventReceiver wraps an event receiver to reconnect according to the reconnect policy if receiving
+// events fails. Events end with io.EOF, without notifying or delaying for a reconnect, if receiving fails once all
+// requested events have been received.
+func reconnectingEventReceiver[T any](
+	ctx context.Context,
+	policy *ReconnectPolicy,
+	receive eventReceiver[T],
+	reconnect func(context.Context) (eventReceiver[T], error),
+	isComplete func() bool,
+) eventReceiver[T] {
+	attempts := 0
+
+	return func() ([]T, error) {
+		for {
+			events, err := receive()
+			if err == nil {
+				attempts = 0
+				return events, nil
+			}
+
+			if isComplete() {
+				return nil, io.EOF
+			}
+
+			if !policy.shouldReconnect(ctx, err, attempts) {
+				return nil, err
+			}
+
+			attempts++
+			if err := sleep(ctx, policy.Backoff.delay(attempts)); err != nil {
+				return nil, err
+			}
+
+			if policy.OnReconnect != nil {
+				policy.OnReconnect(attempts, err)
+			}
+
+			receive, err = reconnect(ctx)
+			if err != nil {
+				receive = failedEventReceiver[T](err)
+			}
+		}
+	}
+}
+
+func failedEventReceiver[T any](err error) eventReceiver[T] {
+	return func() ([]T, error) {
+		return nil, err
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+)
+
+const defaultResubmitMaxAttempts = 3
+
+// ResubmitPolicy specifies how a transaction is resubmitted if it fails to commit due to a read conflict with another
+// transaction.
+type ResubmitPolicy struct {
+	// MaxAttempts is the maximum number of submit attempts, including the initial attempt. Zero uses the default of 3
+	// attempts.
+	MaxAttempts int
+	// Backoff applied between attempts.
+	Backoff Backoff
+	// OnResubmit, if set, is notified before each resubmit attempt with the attempt number, starting at 2 for the first
+	// resubmit, and the commit error for the previous attempt.
+	OnResubmit func(attempt int, cause *CommitError)
+}
+
+func (policy *ResubmitPolicy) maxAttempts() int {
+	return maxAttemptsOrDefault(policy.MaxAttempts, defaultResubmitMaxAttempts)
+}
+
+// shouldResubmit returns the commit error if the submit failed due to a read conflict that allows another attempt.
+func (policy *ResubmitPolicy) shouldResubmit(err error, attempt int) (*CommitError, bool) {
+	if attempt >= policy.maxAttempts() {
+		return nil, false
+	}
+
+	var commitErr *CommitError
+	if !errors.As(err, &commitErr) || !isReadConflict(commitErr.Code) {
+		return nil, false
+	}
+
+	return commitErr, true
+}
+
+func isReadConflict(code peer.TxValidationCode) bool {
+	return code == peer.TxValidationCode_MVCC_READ_CONFLICT || code == peer.TxValidationCode_PHANTOM_READ_CONFLICT
+}
+
+// SubmitWithResubmit submits a transaction to the ledger and returns its result only after it has been committed to
+// the ledger. If the transaction fails to commit with an MVCC_READ_CONFLICT or PHANTOM_READ_CONFLICT validation code,
+// a new proposal with a new transaction ID is created using the same proposal options, and is endorsed and submitted
+// again, up to the maximum number of attempts allowed by the resubmit policy. The transaction function is executed
+// again by endorsing peers for each attempt.
+//
+// The transaction IDs of all attempts are returned in the order they were made, whether or not the submit is
+// successful. The last transaction ID is that of the successfully committed transaction.
+//
+// This method may return different error types depending on the point in the transaction flow that a failure occurs.
+// See the [Contract.Submit] documentation for more details. If all attempts fail to commit, the [CommitError] for the
+// last attempt is returned.
+func (contract *Contract) SubmitWithResubmit(
+	ctx context.Context,
+	transactionName string,
+	policy ResubmitPolicy,
+	options ...ProposalOption,
+) ([]byte, []string, error) {
+	var transactionIDs []string
+
+	for attempt := 1; ; attempt++ {
+		proposal, err := contract.newProposal(ctx, transactionName, options...)
+		if err != nil {
+			return nil, transactionIDs, err
+		}
+
+		transactionIDs = append(transactionIDs, proposal.TransactionID())
+
+		result, err := submitProposal(ctx, proposal)
+		commitErr, resubmit := policy.shouldResubmit(err, attempt)
+		if !resubmit {
+			return result, transactionIDs, err
+		}
+
+		if sleep(ctx, policy.Backoff.delay(attempt)) != nil {
+			return nil, transactionIDs, err
+		}
+
+		if policy.OnResubmit != nil {
+			policy.OnResubmit(attempt+1, commitErr)
+		}
+	}
+}
+
+// submitProposal endorses and submits a proposal, and waits for the transaction to be committed.
+func submitProposal(ctx context.Context, proposal *Proposal) ([]byte, error) {
+	transaction, err := proposal.EndorseWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := transaction.SubmitWithContext(ctx)
+	if err != nil {
+		return transaction.Result(), err
+	}
+
+	status, err := commit.StatusWithContext(ctx)
+	if err != nil {
+		return transaction.Result(), err
+	}
+
+	if !status.Successful {
+		return nil, newCommitError(status.TransactionID, status.Code)
+	}
+
+	return transaction.Result(), nil
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSubmitWithResubmit(t *testing.T) {
+	policy := ResubmitPolicy{
+		Backoff: Backoff{
+			InitialDelay: time.Millisecond,
+			MaxDelay:     time.Millisecond,
+		},
+	}
+
+	newEndorseResponse := func(t *testing.T) *gateway.EndorseResponse {
+		return AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")
+	}
+
+	t.Run("Returns result and transaction ID if committed", func(t *testing.T) {
+		endorseRequests := make(chan *gateway.EndorseRequest, 1)
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, CaptureInvokeRequest(endorseRequests), WithEndorseResponse(newEndorseResponse(t))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		result, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+		require.NoError(t, err)
+
+		require.Equal(t, []byte("TRANSACTION_RESULT"), result, "result")
+		require.Equal(t, []string{(<-endorseRequests).GetTransactionId()}, transactionIDs, "transaction IDs")
+	})
+
+	for _, code := range []peer.TxValidationCode{
+		peer.TxValidationCode_MVCC_READ_CONFLICT,
+		peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+	} {
+		t.Run("Resubmits with new transaction ID on "+code.String(), func(t *testing.T) {
+			endorseRequests := make(chan *gateway.EndorseRequest, 2)
+
+			mockConnection := NewMockClientConnInterface(t)
+			ExpectEndorse(mockConnection, CaptureInvokeRequest(endorseRequests), WithEndorseResponse(newEndorseResponse(t))).Twice()
+			ExpectSubmit(mockConnection).Twice()
+			ExpectCommitStatus(mockConnection, WithCommitStatusResponse(code, 1)).Once()
+			ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 2)).Once()
+
+			contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+			result, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+			require.NoError(t, err)
+
+			require.Equal(t, []byte("TRANSACTION_RESULT"), result, "result")
+			expected := []string{(<-endorseRequests).GetTransactionId(), (<-endorseRequests).GetTransactionId()}
+			require.Equal(t, expected, transactionIDs, "transaction IDs")
+			require.NotEqual(t, transactionIDs[0], transactionIDs[1], "unique transaction IDs")
+		})
+	}
+
+	t.Run("Returns commit error after max attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponse(t))).Times(defaultResubmitMaxAttempts)
+		ExpectSubmit(mockConnection).Times(defaultResubmitMaxAttempts)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 1)).
+			Times(defaultResubmitMaxAttempts)
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+
+		var commitErr *CommitError
+		require.ErrorAs(t, err, &commitErr)
+		require.Equal(t, peer.TxValidationCode_MVCC_READ_CONFLICT, commitErr.Code, "validation code")
+		require.Len(t, transactionIDs, defaultResubmitMaxAttempts, "transaction IDs")
+		require.Equal(t, transactionIDs[len(transactionIDs)-1], commitErr.TransactionID, "transaction ID")
+	})
+
+	t.Run("Uses specified max attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponse(t))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", ResubmitPolicy{MaxAttempts: 1})
+
+		var commitErr *CommitError
+		require.ErrorAs(t, err, &commitErr)
+		require.Len(t, transactionIDs, 1, "transaction IDs")
+	})
+
+	t.Run("Does not resubmit on other commit errors", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponse(t))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+
+		var commitErr *CommitError
+		require.ErrorAs(t, err, &commitErr)
+		require.Equal(t, peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE, commitErr.Code, "validation code")
+		require.Len(t, transactionIDs, 1, "transaction IDs")
+	})
+
+	t.Run("Does not resubmit on endorse error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithInvokeError(NewStatusError(t, codes.Aborted, "ENDORSE_ERROR"))).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+
+		var endorseErr *EndorseError
+		require.ErrorAs(t, err, &endorseErr)
+		require.Equal(t, []string{endorseErr.TransactionID}, transactionIDs, "transaction IDs")
+	})
+
+	t.Run("Notifies resubmit attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponse(t))).Twice()
+		ExpectSubmit(mockConnection).Twice()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 1)).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 2)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		var attempts []int
+		var causes []*CommitError
+		policy := policy
+		policy.OnResubmit = func(attempt int, cause *CommitError) {
+			attempts = append(attempts, attempt)
+			causes = append(causes, cause)
+		}
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(context.Background(), "transaction", policy)
+		require.NoError(t, err)
+
+		require.Equal(t, []int{2}, attempts, "attempts")
+		require.Len(t, causes, 1, "causes")
+		require.Equal(t, transactionIDs[0], causes[0].TransactionID, "cause transaction ID")
+	})
+
+	t.Run("Stops resubmitting if context done", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(newEndorseResponse(t))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, transactionIDs, err := contract.SubmitWithResubmit(ctx, "transaction", ResubmitPolicy{
+			Backoff: Backoff{InitialDelay: time.Minute},
+		})
+
+		var commitErr *CommitError
+		require.ErrorAs(t, err, &commitErr)
+		require.Len(t, transactionIDs, 1, "transaction IDs")
+	})
+}
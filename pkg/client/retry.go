@@ -0,0 +1,115 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultEvaluateMaxAttempts     = 3
+	defaultCommitStatusMaxAttempts = 3
+)
+
+var defaultRetryableCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded}
+
+// RetryPolicy specifies how Gateway requests are retried following a transient failure.
+//
+// Evaluate and commit status requests do not modify the ledger, and are retried by default. Endorse and submit
+// requests are only retried if a maximum number of attempts greater than 1 is explicitly specified. An endorse retry
+// reuses the same signed proposal and transaction ID. A submit retry resends the same signed transaction, which might
+// already have reached the ordering service, in which case the retry fails but the transaction may still be committed.
+type RetryPolicy struct {
+	// EvaluateMaxAttempts is the maximum number of attempts for an evaluate request, including the initial attempt.
+	// Zero uses the default of 3 attempts.
+	EvaluateMaxAttempts int
+	// EndorseMaxAttempts is the maximum number of attempts for an endorse request, including the initial attempt.
+	// Zero uses the default of 1 attempt, with no retry.
+	EndorseMaxAttempts int
+	// SubmitMaxAttempts is the maximum number of attempts for a submit request, including the initial attempt. Zero
+	// uses the default of 1 attempt, with no retry.
+	SubmitMaxAttempts int
+	// CommitStatusMaxAttempts is the maximum number of attempts for a commit status request, including the initial
+	// attempt. Zero uses the default of 3 attempts.
+	CommitStatusMaxAttempts int
+	// Backoff applied between attempts.
+	Backoff Backoff
+	// RetryableCodes are the gRPC status codes for which a failed request is retried. If not specified, requests
+	// failing with Unavailable or DeadlineExceeded status are retried.
+	RetryableCodes []codes.Code
+}
+
+func (policy *RetryPolicy) evaluateMaxAttempts() int {
+	if policy == nil {
+		return 1
+	}
+	return maxAttemptsOrDefault(policy.EvaluateMaxAttempts, defaultEvaluateMaxAttempts)
+}
+
+func (policy *RetryPolicy) endorseMaxAttempts() int {
+	if policy == nil {
+		return 1
+	}
+	return maxAttemptsOrDefault(policy.EndorseMaxAttempts, 1)
+}
+
+func (policy *RetryPolicy) submitMaxAttempts() int {
+	if policy == nil {
+		return 1
+	}
+	return maxAttemptsOrDefault(policy.SubmitMaxAttempts, 1)
+}
+
+func (policy *RetryPolicy) commitStatusMaxAttempts() int {
+	if policy == nil {
+		return 1
+	}
+	return maxAttemptsOrDefault(policy.CommitStatusMaxAttempts, defaultCommitStatusMaxAttempts)
+}
+
+func maxAttemptsOrDefault(maxAttempts int, defaultMaxAttempts int) int {
+	if maxAttempts <= 0 {
+		return defaultMaxAttempts
+	}
+	return maxAttempts
+}
+
+// isRetryable returns true if the error has a gRPC status code that should be retried.
+func (policy *RetryPolicy) isRetryable(err error) bool {
+	retryableCodes := policy.RetryableCodes
+	if len(retryableCodes) == 0 {
+		retryableCodes = defaultRetryableCodes
+	}
+
+	return slices.Contains(retryableCodes, status.Code(err))
+}
+
+// WithRetryPolicy retries Gateway requests that fail with a transient error, according to the supplied retry policy.
+// Any timeout or deadline applies to the request as a whole, including all retry attempts.
+func WithRetryPolicy(policy RetryPolicy) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.retryPolicy = &policy
+		return nil
+	}
+}
+
+// invokeWithRetry invokes a call, retrying up to the maximum number of attempts while the call fails with a retryable
+// error. The error from the last attempt is returned if all attempts fail, or the context is done.
+func invokeWithRetry[T any](ctx context.Context, policy *RetryPolicy, maxAttempts int, call func() (T, error)) (T, error) {
+	result, err := call()
+
+	for attempt := 1; attempt < maxAttempts && err != nil && policy.isRetryable(err); attempt++ {
+		if sleep(ctx, policy.Backoff.delay(attempt)) != nil {
+			break
+		}
+
+		result, err = call()
+	}
+
+	return result, err
+}
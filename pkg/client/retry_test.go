@@ -0,0 +1,230 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	backoff := Backoff{
+		InitialDelay: time.Millisecond,
+		MaxDelay:     time.Millisecond,
+	}
+	unavailableErr := NewStatusError(t, codes.Unavailable, "UNAVAILABLE")
+
+	t.Run("Evaluate retried on unavailable error", func(t *testing.T) {
+		expected := []byte("TRANSACTION_RESULT")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(unavailableErr)).Once()
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(expected)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		actual, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Evaluate retried on deadline exceeded error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(NewStatusError(t, codes.DeadlineExceeded, "DEADLINE"))).Once()
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Evaluate uses default max attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(unavailableErr)).Times(defaultEvaluateMaxAttempts)
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+
+	t.Run("Evaluate uses specified max attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(unavailableErr)).Times(5)
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			EvaluateMaxAttempts: 5,
+			Backoff:             backoff,
+		}))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+
+	t.Run("Evaluate not retried on non-retryable error", func(t *testing.T) {
+		expected := NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(expected)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.ErrorIs(t, err, expected)
+	})
+
+	t.Run("Evaluate retried on specified retryable codes", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(NewStatusError(t, codes.ResourceExhausted, "BUSY"))).Once()
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff:        backoff,
+			RetryableCodes: []codes.Code{codes.ResourceExhausted},
+		}))
+
+		_, err := contract.EvaluateTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Evaluate not retried if context done", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(unavailableErr)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: Backoff{InitialDelay: time.Minute},
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := contract.EvaluateWithContext(ctx, "transaction")
+
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+
+	t.Run("Evaluate not retried without retry policy", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(unavailableErr)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+
+		_, err := contract.EvaluateTransaction("transaction")
+
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+
+	t.Run("Endorse not retried by default", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithInvokeError(unavailableErr)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var endorseErr *EndorseError
+		require.ErrorAs(t, err, &endorseErr)
+	})
+
+	t.Run("Endorse retried if max attempts specified", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithInvokeError(unavailableErr)).Once()
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			EndorseMaxAttempts: 2,
+			Backoff:            backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Submit not retried by default", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection, WithInvokeError(unavailableErr)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var submitErr *SubmitError
+		require.ErrorAs(t, err, &submitErr)
+	})
+
+	t.Run("Submit retried if max attempts specified", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection, WithInvokeError(unavailableErr)).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			SubmitMaxAttempts: 2,
+			Backoff:           backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Commit status retried on unavailable error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithInvokeError(unavailableErr)).Once()
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Once()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			Backoff: backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+		require.NoError(t, err)
+	})
+
+	t.Run("Commit status error returned after max attempts", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network"))).Once()
+		ExpectSubmit(mockConnection).Once()
+		ExpectCommitStatus(mockConnection, WithInvokeError(unavailableErr)).Twice()
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection), WithRetryPolicy(RetryPolicy{
+			CommitStatusMaxAttempts: 2,
+			Backoff:                 backoff,
+		}))
+
+		_, err := contract.SubmitTransaction("transaction")
+
+		var commitStatusErr *CommitStatusError
+		require.ErrorAs(t, err, &commitStatusErr)
+		require.Equal(t, codes.Unavailable, status.Code(err), "status code")
+	})
+}
@@ -0,0 +1,285 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrShutdown is returned by operations attempted after [Gateway.Shutdown] has been called.
+var ErrShutdown = errors.New("gateway is shut down")
+
+// Shutdown gracefully shuts down the Gateway. New operations, including new event streams, are rejected with
+// [ErrShutdown]. Shutdown then waits for in-progress operations to complete, after which event streams are ended
+// cleanly and Shutdown waits for them to finish. Once everything has finished, the Gateway is closed.
+//
+// A submit remains in progress until the commit status of the transaction is successfully obtained, the context
+// supplied to the submit is done, or the Gateway is closed. Submits that do not supply a context, such as
+// [Contract.SubmitAsync] and [Transaction.Submit], remain in progress until the commit status is obtained or the
+// Gateway is closed. Commit status requests for submitted transactions are allowed while Shutdown is waiting.
+//
+// If the context is done before in-progress operations complete, Shutdown returns the context error along with the
+// IDs of submitted transactions whose commit status is not yet known. These transactions may or may not be
+// committed, and their status should be checked later. The in-progress operations are not cancelled; [Gateway.Close]
+// can be used to abort them.
+func (gw *Gateway) Shutdown(ctx context.Context) ([]string, error) {
+	if transactionIDs, err := gw.client.tracker.shutdown(ctx); err != nil {
+		return transactionIDs, err
+	}
+
+	gw.cancel()
+	return nil, nil
+}
+
+// operationTracker tracks in-progress operations and event streams so that shutdown can wait for them to finish.
+type operationTracker struct {
+	ctx          context.Context
+	lock         sync.Mutex
+	isShutdown   bool
+	nextID       uint64
+	operations   map[uint64]*trackedOperation
+	streams      map[uint64]*trackedStream
+	operationsWG sync.WaitGroup
+	streamsWG    sync.WaitGroup
+}
+
+type trackedOperation struct {
+	operation     Operation
+	transactionID string
+	done          func()
+	// stops are invoked on completion to unregister context callbacks.
+	stops []func() bool
+}
+
+// newOperationTracker creates a tracker for a Gateway. Submits remain in progress no longer than the supplied Gateway
+// context.
+func newOperationTracker(ctx context.Context) *operationTracker {
+	return &operationTracker{
+		ctx:        ctx,
+		operations: make(map[uint64]*trackedOperation),
+		streams:    make(map[uint64]*trackedStream),
+	}
+}
+
+// track invokes an operation call if the tracker is not shut down.
+func track[T any](tracker *operationTracker, operation Operation, transactionID string, call func() (T, error)) (T, error) {
+	done, err := tracker.begin(operation, transactionID)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer done()
+
+	return call()
+}
+
+// begin registers an in-progress operation, returning a function that must be called when the operation completes.
+// The returned function may safely be called more than once.
+func (tracker *operationTracker) begin(operation Operation, transactionID string) (func(), error) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.isShutdown && !tracker.isSubmitted(operation, transactionID) {
+		return nil, ErrShutdown
+	}
+
+	return tracker.add(operation, transactionID).done, nil
+}
+
+// add registers an in-progress operation. The tracker lock must be held by the caller.
+func (tracker *operationTracker) add(operation Operation, transactionID string) *trackedOperation {
+	id := tracker.nextID
+	tracker.nextID++
+
+	result := &trackedOperation{
+		operation:     operation,
+		transactionID: transactionID,
+	}
+	result.done = sync.OnceFunc(func() {
+		tracker.lock.Lock()
+		delete(tracker.operations, id)
+		stops := result.stops
+		tracker.lock.Unlock()
+
+		for _, stop := range stops {
+			stop()
+		}
+		tracker.operationsWG.Done()
+	})
+
+	tracker.operations[id] = result
+	tracker.operationsWG.Add(1)
+
+	return result
+}
+
+// beginSubmit registers an in-progress submit, which remains in progress until the commit status of the transaction
+// is obtained, the supplied context is done, or the Gateway context is done. The returned function completes the
+// submit early, and may safely be called more than once.
+func (tracker *operationTracker) beginSubmit(ctx context.Context, transactionID string) (func(), error) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.isShutdown {
+		return nil, ErrShutdown
+	}
+
+	submit := tracker.add(OperationSubmit, transactionID)
+	submit.stops = append(submit.stops, context.AfterFunc(ctx, submit.done))
+	if tracker.ctx != nil && tracker.ctx != ctx {
+		submit.stops = append(submit.stops, context.AfterFunc(tracker.ctx, submit.done))
+	}
+
+	return submit.done, nil
+}
+
+// completeSubmit completes any in-progress submit of a transaction once its commit status is known.
+func (tracker *operationTracker) completeSubmit(transactionID string) {
+	tracker.lock.Lock()
+	var completions []func()
+	for _, tracked := range tracker.operations {
+		if tracked.operation == OperationSubmit && tracked.transactionID == transactionID {
+			completions = append(completions, tracked.done)
+		}
+	}
+	tracker.lock.Unlock()
+
+	for _, done := range completions {
+		done()
+	}
+}
+
+// isSubmitted reports whether an operation is a commit status request for a transaction with an in-progress submit.
+// The tracker lock must be held by the caller.
+func (tracker *operationTracker) isSubmitted(operation Operation, transactionID string) bool {
+	if operation != OperationCommitStatus {
+		return false
+	}
+
+	for _, tracked := range tracker.operations {
+		if tracked.operation == OperationSubmit && tracked.transactionID == transactionID {
+			return true
+		}
+	}
+	return false
+}
+
+// beginStream registers a new event stream, returning a stream context that is cancelled when the stream is ended by
+// shutdown. The stream must be ended by calling its end method once it is no longer used.
+func (tracker *operationTracker) beginStream(ctx context.Context) (*trackedStream, error) {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	if tracker.isShutdown {
+		return nil, ErrShutdown
+	}
+
+	id := tracker.nextID
+	tracker.nextID++
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream := &trackedStream{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	tracker.streams[id] = stream
+	tracker.streamsWG.Add(1)
+
+	context.AfterFunc(ctx, func() {
+		tracker.lock.Lock()
+		delete(tracker.streams, id)
+		tracker.lock.Unlock()
+
+		tracker.streamsWG.Done()
+	})
+
+	return stream, nil
+}
+
+func (tracker *operationTracker) shutdown(ctx context.Context) ([]string, error) {
+	tracker.lock.Lock()
+	tracker.isShutdown = true
+	tracker.lock.Unlock()
+
+	if err := waitGroupWithContext(ctx, &tracker.operationsWG); err != nil {
+		return tracker.unknownTransactionIDs(), err
+	}
+
+	tracker.endStreams()
+
+	return nil, waitGroupWithContext(ctx, &tracker.streamsWG)
+}
+
+// unknownTransactionIDs returns the IDs of transactions with in-progress operations that may result in the
+// transaction being committed.
+func (tracker *operationTracker) unknownTransactionIDs() []string {
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+
+	var results []string
+	for _, operation := range tracker.operations {
+		if operation.operation != OperationSubmit && operation.operation != OperationCommitStatus {
+			continue
+		}
+		if !slices.Contains(results, operation.transactionID) {
+			results = append(results, operation.transactionID)
+		}
+	}
+	return results
+}
+
+func (tracker *operationTracker) endStreams() {
+	tracker.lock.Lock()
+	streams := make([]*trackedStream, 0, len(tracker.streams))
+	for _, stream := range tracker.streams {
+		streams = append(streams, stream)
+	}
+	tracker.lock.Unlock()
+
+	for _, stream := range streams {
+		stream.endedByShutdown.Store(true)
+		stream.cancel()
+	}
+}
+
+func waitGroupWithContext(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// trackedStream is an event stream registered with an operation tracker.
+type trackedStream struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	endedByShutdown atomic.Bool
+}
+
+// end the stream, releasing its resources.
+func (stream *trackedStream) end() {
+	stream.cancel()
+}
+
+// receiveError returns io.EOF if the stream was ended by shutdown so that the stream ends cleanly; otherwise the receive
+// error is returned.
+func (stream *trackedStream) receiveError(err error) error {
+	if stream.endedByShutdown.Load() {
+		return io.EOF
+	}
+	return err
+}
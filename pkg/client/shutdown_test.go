@@ -0,0 +1,334 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockInvoke causes the invoke to wait until the release channel is closed, and notifies the started channel when the
+// invoke begins.
+func blockInvoke(started chan<- struct{}, release <-chan struct{}) invokeFunction {
+	return func(context.Context, string, any, any, ...grpc.CallOption) error {
+		close(started)
+		<-release
+		return nil
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Run("Operations after shutdown fail", func(t *testing.T) {
+		gateway := AssertNewTestGateway(t)
+
+		transactionIDs, err := gateway.Shutdown(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, transactionIDs)
+
+		_, err = gateway.GetNetwork("NETWORK").GetContract("CHAINCODE").EvaluateTransaction("TRANSACTION")
+		require.ErrorIs(t, err, ErrShutdown)
+	})
+
+	t.Run("New event streams after shutdown fail", func(t *testing.T) {
+		gateway := AssertNewTestGateway(t)
+
+		_, err := gateway.Shutdown(context.Background())
+		require.NoError(t, err)
+
+		_, err = gateway.GetNetwork("NETWORK").ChaincodeEvents(context.Background(), "CHAINCODE")
+		require.ErrorIs(t, err, ErrShutdown)
+	})
+
+	t.Run("Waits for in-progress operations to complete", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, blockInvoke(started, release), WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		submitErr := make(chan error, 1)
+		go func() {
+			_, err := contract.SubmitTransaction("TRANSACTION")
+			submitErr <- err
+		}()
+		<-started
+
+		shutdownErr := make(chan error, 1)
+		go func() {
+			_, err := gateway.Shutdown(context.Background())
+			shutdownErr <- err
+		}()
+
+		select {
+		case <-shutdownErr:
+			require.FailNow(t, "shutdown completed before in-progress operation")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(release)
+		require.NoError(t, <-submitErr, "submit")
+		require.NoError(t, <-shutdownErr, "shutdown")
+	})
+
+	t.Run("Gets commit status of transaction submitted before shutdown", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection, blockInvoke(started, release))
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		submitErr := make(chan error, 1)
+		go func() {
+			_, err := contract.SubmitTransaction("TRANSACTION")
+			submitErr <- err
+		}()
+		<-started
+
+		shutdownResult := make(chan []string, 1)
+		shutdownErr := make(chan error, 1)
+		go func() {
+			transactionIDs, err := gateway.Shutdown(context.Background())
+			shutdownResult <- transactionIDs
+			shutdownErr <- err
+		}()
+
+		select {
+		case <-shutdownErr:
+			require.FailNow(t, "shutdown completed before in-progress submit")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		close(release)
+		require.NoError(t, <-submitErr, "submit")
+		require.Empty(t, <-shutdownResult, "transaction IDs")
+		require.NoError(t, <-shutdownErr, "shutdown")
+	})
+
+	t.Run("Waits for commit status of asynchronously submitted transaction", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		_, commit, err := contract.SubmitAsync("TRANSACTION")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		transactionIDs, err := gateway.Shutdown(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, []string{commit.TransactionID()}, transactionIDs)
+
+		status, err := commit.Status()
+		require.NoError(t, err, "commit status after shutdown started")
+		require.True(t, status.Successful)
+
+		transactionIDs, err = gateway.Shutdown(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, transactionIDs)
+	})
+
+	t.Run("Commit status of off-line signed commit completes submit", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+		transaction, err := proposal.Endorse()
+		require.NoError(t, err)
+		unsignedCommit, err := transaction.Submit()
+		require.NoError(t, err)
+
+		commitBytes, err := unsignedCommit.Bytes()
+		require.NoError(t, err)
+		commit, err := gateway.NewSignedCommit(commitBytes, []byte("SIGNATURE"))
+		require.NoError(t, err)
+
+		_, err = commit.Status()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		transactionIDs, err := gateway.Shutdown(ctx)
+		require.NoError(t, err)
+		require.Empty(t, transactionIDs)
+	})
+
+	t.Run("Submit completes when context is done after commit status error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, func(ctx context.Context, _ string, _ any, _ any, _ ...grpc.CallOption) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		submitCtx, submitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer submitCancel()
+
+		_, commit, err := contract.SubmitAsyncWithContext(submitCtx, "TRANSACTION")
+		require.NoError(t, err)
+
+		_, err = commit.StatusWithContext(submitCtx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		transactionIDs, err := gateway.Shutdown(ctx)
+		require.NoError(t, err)
+		require.Empty(t, transactionIDs)
+	})
+
+	t.Run("Submit completes when Gateway is closed", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		_, _, err := contract.SubmitAsync("TRANSACTION")
+		require.NoError(t, err)
+
+		require.NoError(t, gateway.Close())
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		transactionIDs, err := gateway.Shutdown(ctx)
+		require.NoError(t, err)
+		require.Empty(t, transactionIDs)
+	})
+
+	t.Run("Returns transaction IDs of in-progress operations after deadline", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection, blockInvoke(started, release))
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1)).Maybe()
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		contract := gateway.GetNetwork("network").GetContract("CHAINCODE")
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+		transaction, err := proposal.Endorse()
+		require.NoError(t, err)
+
+		go func() {
+			_, _ = transaction.Submit()
+		}()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		transactionIDs, err := gateway.Shutdown(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, []string{proposal.TransactionID()}, transactionIDs)
+	})
+
+	t.Run("Event streams end cleanly", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		captureContext := func(ctx context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			contexts <- ctx
+			return nil, nil
+		}
+
+		mockStream := NewMockClientStream(t)
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		ExpectRecvMsg(mockStream, func(any) error {
+			<-(<-contexts).Done()
+			return status.Error(codes.Canceled, "CANCELED")
+		})
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection, captureContext, WithNewStreamResult(mockStream))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		events, err := gateway.GetNetwork("network").ChaincodeEvents(context.Background(), "CHAINCODE")
+		require.NoError(t, err)
+
+		_, err = gateway.Shutdown(context.Background())
+		require.NoError(t, err)
+
+		_, ok := <-events
+		require.False(t, ok, "event channel closed")
+	})
+
+	t.Run("Event stream iteration ends without error", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		captureContext := func(ctx context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			contexts <- ctx
+			return nil, nil
+		}
+
+		mockStream := NewMockClientStream(t)
+		ExpectSendMsg(mockStream)
+		mockStream.EXPECT().CloseSend().Return(nil)
+		received := make(chan struct{})
+		ExpectRecvMsg(mockStream, func(any) error {
+			ctx := <-contexts
+			close(received)
+			<-ctx.Done()
+			return status.Error(codes.Canceled, "CANCELED")
+		})
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectChaincodeEvents(mockConnection, captureContext, WithNewStreamResult(mockStream))
+
+		gateway := AssertNewTestGateway(t, WithClientConnection(mockConnection))
+		request, err := gateway.GetNetwork("network").NewChaincodeEventsRequest("CHAINCODE")
+		require.NoError(t, err)
+
+		iterateErr := make(chan error, 1)
+		go func() {
+			for _, err := range request.Iterate(context.Background()) {
+				iterateErr <- err
+				return
+			}
+			iterateErr <- nil
+		}()
+		<-received
+
+		_, err = gateway.Shutdown(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, <-iterateErr)
+	})
+}
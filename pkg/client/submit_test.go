@@ -7,7 +7,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
 	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -534,6 +537,78 @@ func TestSubmitTransaction(t *testing.T) {
 		require.Equal(t, expectedBlockNumber, status.BlockNumber)
 	})
 
+	t.Run("Transaction returns namespace read-write sets", func(t *testing.T) {
+		expected := &kvrwset.KVRWSet{
+			Reads: []*kvrwset.KVRead{
+				{
+					Key: "READ_KEY",
+				},
+			},
+			Writes: []*kvrwset.KVWrite{
+				{
+					Key:   "WRITE_KEY",
+					Value: []byte("VALUE"),
+				},
+			},
+		}
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "chaincode",
+					Rwset:     AssertMarshal(t, expected),
+				},
+			},
+		}
+		endorseResponse := &gateway.EndorseResponse{
+			PreparedTransaction: &common.Envelope{
+				Payload: AssertMarshal(t, &common.Payload{
+					Header: &common.Header{
+						ChannelHeader: AssertMarshal(t, &common.ChannelHeader{
+							Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+							ChannelId: "network",
+						}),
+					},
+					Data: AssertMarshal(t, &peer.Transaction{
+						Actions: []*peer.TransactionAction{
+							{
+								Payload: AssertMarshal(t, &peer.ChaincodeActionPayload{
+									Action: &peer.ChaincodeEndorsedAction{
+										ProposalResponsePayload: AssertMarshal(t, &peer.ProposalResponsePayload{
+											Extension: AssertMarshal(t, &peer.ChaincodeAction{
+												Results: AssertMarshal(t, readWriteSet),
+												Response: &peer.Response{
+													Payload: []byte("TRANSACTION_RESULT"),
+												},
+											}),
+										}),
+									},
+								}),
+							},
+						},
+					}),
+				}),
+			},
+		}
+
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(endorseResponse))
+
+		contract := AssertNewTestContract(t, "chaincode", WithClientConnection(mockConnection))
+		proposal, err := contract.NewProposal("transaction")
+		require.NoError(t, err, "NewProposal")
+
+		transaction, err := proposal.Endorse()
+		require.NoError(t, err, "Endorse")
+
+		actual, err := transaction.NamespaceReadWriteSets()
+		require.NoError(t, err, "NamespaceReadWriteSets")
+
+		require.Len(t, actual, 1)
+		require.Equal(t, "chaincode", actual[0].Namespace)
+		AssertProtoEqual(t, expected, actual[0].ReadWriteSet)
+	})
+
 	t.Run("Uses default context for endorse", func(t *testing.T) {
 		mockConnection := NewMockClientConnInterface(t)
 		ExpectEndorse(mockConnection, WithInvokeContextErr(), WithEndorseResponse(defaultEndorseResponse))
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const tracerName = "github.com/hyperledger/fabric-gateway/pkg/client"
+
+// Span attribute keys.
+const (
+	attributeTransactionID          = attribute.Key("fabric.transaction_id")
+	attributeChannel                = attribute.Key("fabric.channel")
+	attributeChaincode              = attribute.Key("fabric.chaincode")
+	attributeTransactionName        = attribute.Key("fabric.transaction_name")
+	attributeEndorsingOrganizations = attribute.Key("fabric.endorsing_organizations")
+	attributeValidationCode         = attribute.Key("fabric.validation_code")
+	attributeBlockNumber            = attribute.Key("fabric.block_number")
+)
+
+// WithTracerProvider enables OpenTelemetry tracing using tracers obtained from the supplied provider. Spans are created
+// for building a proposal, signing, endorsing, submitting, evaluating and obtaining commit status. Spans include
+// attributes such as the transaction ID, channel, chaincode and, where applicable, the endorsing organizations and the
+// transaction validation code.
+//
+// Trace context is propagated to the Gateway peer in gRPC metadata using the propagator specified by
+// [WithTextMapPropagator], or the global propagator if none is specified.
+//
+// Spans are children of any span in the context passed to context-aware methods, such as
+// [Contract.SubmitWithContext]. To group the spans for a transaction invocation under a single parent span, use these
+// methods with a context containing the parent span.
+func WithTracerProvider(provider trace.TracerProvider) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.tracer = provider.Tracer(tracerName)
+		return nil
+	}
+}
+
+// WithTextMapPropagator specifies the propagator used to send trace context to the Gateway peer when tracing is enabled
+// using [WithTracerProvider].
+func WithTextMapPropagator(propagator propagation.TextMapPropagator) ConnectOption {
+	return func(gw *Gateway) error {
+		gw.client.propagator = propagator
+		return nil
+	}
+}
+
+// withSpan invokes a call within a new span, if tracing is enabled. Span attributes are only created if tracing is
+// enabled. The result attributes function, which may be nil, adds attributes for a successful result.
+func withSpan[T any](
+	ctx context.Context,
+	client *gatewayClient,
+	name string,
+	kind trace.SpanKind,
+	attributes func() []attribute.KeyValue,
+	call func(context.Context) (T, error),
+	resultAttributes func(T) []attribute.KeyValue,
+) (T, error) {
+	if client.tracer == nil {
+		return call(ctx)
+	}
+
+	ctx, span := client.tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attributes()...))
+	defer span.End()
+
+	result, err := call(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return result, err
+	}
+
+	if resultAttributes != nil {
+		span.SetAttributes(resultAttributes(result)...)
+	}
+
+	return result, nil
+}
+
+// traceSign invokes a signing function within a new span, if tracing is enabled and the message is not already signed.
+// Signing failures are logged, if logging is enabled.
+func (client *gatewayClient) traceSign(
+	ctx context.Context,
+	attributes func() []attribute.KeyValue,
+	isSigned func() bool,
+	sign func() error,
+) error {
+	if isSigned() {
+		return nil
+	}
+
+	_, err := withSpan(ctx, client, "Sign", trace.SpanKindInternal, attributes, func(context.Context) (any, error) {
+		return nil, sign()
+	}, nil)
+	if err != nil {
+		client.logSignFailure(ctx, attributes, err)
+	}
+	return err
+}
+
+// withTraceContext returns a context whose outgoing gRPC metadata includes the trace context, if tracing is enabled.
+func (client *gatewayClient) withTraceContext(ctx context.Context) context.Context {
+	if client.tracer == nil {
+		return ctx
+	}
+
+	propagator := client.propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	propagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// metadataCarrier adapts gRPC metadata to a carrier for trace context propagation.
+type metadataCarrier metadata.MD
+
+func (carrier metadataCarrier) Get(key string) string {
+	values := metadata.MD(carrier).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+func (carrier metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func newTestTracerProvider(t *testing.T) (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return provider, recorder
+}
+
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	var results []string
+	for _, span := range spans {
+		results = append(results, span.Name())
+	}
+	return results
+}
+
+func findSpan(t *testing.T, spans []sdktrace.ReadOnlySpan, name string) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if span.Name() == name {
+			return span
+		}
+	}
+
+	require.FailNow(t, "span not found: "+name)
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	t.Run("Submit creates spans for each step as children of context span", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_VALID, 1))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTracerProvider(provider))
+
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+		_, err := contract.SubmitWithContext(ctx, "TRANSACTION")
+		require.NoError(t, err)
+		parent.End()
+
+		spans := recorder.Ended()
+		expected := []string{"BuildProposal", "Sign", "Endorse", "Sign", "Submit", "Sign", "CommitStatus", "parent"}
+		require.Equal(t, expected, spanNames(spans))
+
+		for _, name := range []string{"BuildProposal", "Endorse", "Submit", "CommitStatus"} {
+			span := findSpan(t, spans, name)
+			require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID(), "parent of %s", name)
+			require.Equal(t, parent.SpanContext().TraceID(), span.SpanContext().TraceID(), "trace of %s", name)
+		}
+	})
+
+	t.Run("Endorse span includes proposal attributes", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTracerProvider(provider))
+
+		proposal, err := contract.NewProposal("TRANSACTION", WithEndorsingOrganizations("Org1MSP", "Org2MSP"))
+		require.NoError(t, err)
+		_, err = proposal.Endorse()
+		require.NoError(t, err)
+
+		span := findSpan(t, recorder.Ended(), "Endorse")
+		require.Equal(t, trace.SpanKindClient, span.SpanKind(), "span kind")
+		require.Subset(t, span.Attributes(), []attribute.KeyValue{
+			attributeTransactionID.String(proposal.TransactionID()),
+			attributeChannel.String(contract.channelName),
+			attributeChaincode.String("CHAINCODE"),
+			attributeTransactionName.String("TRANSACTION"),
+			attributeEndorsingOrganizations.StringSlice([]string{"Org1MSP", "Org2MSP"}),
+		})
+	})
+
+	t.Run("Build proposal span includes transaction ID", func(t *testing.T) {
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithTracerProvider(provider))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+
+		span := findSpan(t, recorder.Ended(), "BuildProposal")
+		require.Subset(t, span.Attributes(), []attribute.KeyValue{
+			attributeTransactionID.String(proposal.TransactionID()),
+			attributeChaincode.String("CHAINCODE"),
+			attributeTransactionName.String("TRANSACTION"),
+		})
+	})
+
+	t.Run("Commit status span includes validation code and block number", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(AssertNewEndorseResponse(t, "TRANSACTION_RESULT", "network")))
+		ExpectSubmit(mockConnection)
+		ExpectCommitStatus(mockConnection, WithCommitStatusResponse(peer.TxValidationCode_MVCC_READ_CONFLICT, 101))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTracerProvider(provider))
+
+		_, commit, err := contract.SubmitAsync("TRANSACTION")
+		require.NoError(t, err)
+		_, err = commit.Status()
+		require.NoError(t, err)
+
+		span := findSpan(t, recorder.Ended(), "CommitStatus")
+		require.Subset(t, span.Attributes(), []attribute.KeyValue{
+			attributeTransactionID.String(commit.TransactionID()),
+			attributeChannel.String("network"),
+			attributeValidationCode.String(peer.TxValidationCode_MVCC_READ_CONFLICT.String()),
+			attributeBlockNumber.Int64(101),
+		})
+	})
+
+	t.Run("Span records error", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithInvokeError(NewStatusError(t, codes.Aborted, "EVALUATE_ERROR")))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTracerProvider(provider))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.Error(t, err)
+
+		span := findSpan(t, recorder.Ended(), "Evaluate")
+		require.Equal(t, otelcodes.Error, span.Status().Code, "status code")
+		require.Contains(t, span.Status().Description, "EVALUATE_ERROR", "status description")
+	})
+
+	t.Run("No sign span for off-line signed proposal", func(t *testing.T) {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, WithEvaluateResponse(nil))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTracerProvider(provider))
+
+		proposal, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err)
+		proposal.setSignature([]byte("SIGNATURE"))
+
+		_, err = proposal.Evaluate()
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"BuildProposal", "Evaluate"}, spanNames(recorder.Ended()))
+	})
+
+	t.Run("Trace context propagated in gRPC metadata", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, CaptureInvokeContext(contexts))
+
+		provider, recorder := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection),
+			WithTracerProvider(provider), WithTextMapPropagator(propagation.TraceContext{}))
+
+		_, err := contract.EvaluateTransaction("TRANSACTION")
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(<-contexts)
+		actual := propagation.TraceContext{}.Extract(context.Background(), metadataCarrier(md))
+
+		span := findSpan(t, recorder.Ended(), "Evaluate")
+		require.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(actual).TraceID(), "trace ID")
+		require.Equal(t, span.SpanContext().SpanID(), trace.SpanContextFromContext(actual).SpanID(), "span ID")
+	})
+
+	t.Run("Existing gRPC metadata retained", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, CaptureInvokeContext(contexts))
+
+		provider, _ := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection),
+			WithTracerProvider(provider), WithTextMapPropagator(propagation.TraceContext{}))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "key", "VALUE")
+		_, err := contract.EvaluateWithContext(ctx, "TRANSACTION")
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(<-contexts)
+		require.Equal(t, []string{"VALUE"}, md.Get("key"))
+		require.NotEmpty(t, md.Get("traceparent"))
+	})
+
+	t.Run("Trace context not propagated if tracing not enabled", func(t *testing.T) {
+		contexts := make(chan context.Context, 1)
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEvaluate(mockConnection, CaptureInvokeContext(contexts))
+
+		provider, _ := newTestTracerProvider(t)
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection), WithTextMapPropagator(propagation.TraceContext{}))
+
+		ctx, span := provider.Tracer("test").Start(context.Background(), "parent")
+		defer span.End()
+
+		_, err := contract.EvaluateWithContext(ctx, "TRANSACTION")
+		require.NoError(t, err)
+
+		md, _ := metadata.FromOutgoingContext(<-contexts)
+		require.Empty(t, md.Get("traceparent"))
+	})
+}
@@ -7,7 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hyperledger/fabric-gateway/pkg/parser"
 	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
@@ -42,6 +45,17 @@ func (transaction *Transaction) Result() []byte {
 	return transaction.result
 }
 
+// NamespaceReadWriteSets returns the ledger reads and writes made by the endorsed transaction, for each namespace in
+// which reads or writes were made. This can be used to inspect the keys a transaction touches before it is submitted.
+func (transaction *Transaction) NamespaceReadWriteSets() ([]*parser.NamespaceReadWriteSet, error) {
+	parsedTransaction, err := parser.ParseEnvelope(transaction.preparedTransaction.GetEnvelope())
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedTransaction.NamespaceReadWriteSets(), nil
+}
+
 // Bytes of the serialized transaction.
 func (transaction *Transaction) Bytes() ([]byte, error) {
 	transactionBytes, err := proto.Marshal(transaction.preparedTransaction)
@@ -64,24 +78,43 @@ func (transaction *Transaction) TransactionID() string {
 
 // Submit the transaction to the orderer for commit to the ledger.
 func (transaction *Transaction) Submit(opts ...grpc.CallOption) (*Commit, error) {
-	return transaction.submit(transaction.client.Submit, opts...)
+	ctx, cancel := transaction.client.contexts.Submit()
+	defer cancel()
+
+	// The submit context ends on return, so track the submit for the lifetime of the Gateway instead
+	return transaction.submitWithSpan(ctx, transaction.client.contexts.ctx, opts...)
 }
 
 // SubmitWithContext uses the supplied context to submit the transaction to the orderer for commit to the ledger.
 func (transaction *Transaction) SubmitWithContext(ctx context.Context, opts ...grpc.CallOption) (*Commit, error) {
-	return transaction.submit(
-		func(in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error) {
-			return transaction.client.SubmitWithContext(ctx, in, opts...)
-		},
-		opts...,
-	)
-}
-
-func (transaction *Transaction) submit(
-	call func(in *gateway.SubmitRequest, opts ...grpc.CallOption) (*gateway.SubmitResponse, error),
-	opts ...grpc.CallOption,
-) (*Commit, error) {
-	if err := transaction.sign(); err != nil {
+	return transaction.submitWithSpan(ctx, ctx, opts...)
+}
+
+func (transaction *Transaction) submitWithSpan(ctx context.Context, trackingCtx context.Context, opts ...grpc.CallOption) (*Commit, error) {
+	return withSpan(ctx, transaction.client, "Submit", trace.SpanKindClient, transaction.attributes, func(ctx context.Context) (*Commit, error) {
+		return transaction.submit(ctx, trackingCtx, opts...)
+	}, nil)
+}
+
+// submit the transaction, which remains tracked as in-progress until its commit status is obtained or the tracking
+// context is done, so that shutdown allows the commit status to be obtained.
+func (transaction *Transaction) submit(ctx context.Context, trackingCtx context.Context, opts ...grpc.CallOption) (*Commit, error) {
+	done, err := transaction.client.tracker.beginSubmit(trackingCtx, transaction.TransactionID())
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := transaction.submitTracked(ctx, opts...)
+	if err != nil {
+		done()
+		return nil, err
+	}
+
+	return commit, nil
+}
+
+func (transaction *Transaction) submitTracked(ctx context.Context, opts ...grpc.CallOption) (*Commit, error) {
+	if err := transaction.client.traceSign(ctx, transaction.attributes, transaction.isSigned, transaction.sign); err != nil {
 		return nil, err
 	}
 
@@ -96,7 +129,7 @@ func (transaction *Transaction) submit(
 		ChannelId:           transaction.channelID,
 		PreparedTransaction: transaction.preparedTransaction.GetEnvelope(),
 	}
-	_, err = call(submitRequest, opts...)
+	_, err = transaction.client.SubmitWithContext(ctx, submitRequest, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -150,3 +183,13 @@ func (transaction *Transaction) newSignedCommitStatusRequest() (*gateway.SignedC
 	}
 	return signedRequest, nil
 }
+
+func (transaction *Transaction) attributes() []attribute.KeyValue {
+	chaincodeName, transactionName := parseChaincodeInvocationFromEnvelope(transaction.preparedTransaction.GetEnvelope())
+	return []attribute.KeyValue{
+		attributeTransactionID.String(transaction.TransactionID()),
+		attributeChannel.String(transaction.channelID),
+		attributeChaincode.String(chaincodeName),
+		attributeTransactionName.String(transactionName),
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/fabric-gateway/pkg/parser"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// TransactionContent is the endorsed content of a transaction, parsed from the prepared transaction that is submitted
+// to the orderer. Off-line approvers can use it to check which organizations endorsed the transaction, and the effects
+// of the transaction, before it is submitted.
+type TransactionContent struct {
+	TransactionID string
+	ChannelName   string
+	ChaincodeName string
+	// TransactionName is the transaction function name, which may be qualified with a contract name.
+	TransactionName string
+	// Arguments are the transaction function arguments, excluding the transaction function name.
+	Arguments [][]byte
+	// Status of the chaincode response, such as 200 for success.
+	Status int32
+	// Message of the chaincode response, which is typically only set for errors.
+	Message string
+	// Result of the transaction function, which is the chaincode response payload.
+	Result []byte
+	// Event emitted by the transaction function, or nil if no event was emitted. The event is delivered to listeners
+	// only if the transaction is committed successfully. The block number is zero since the transaction has not been
+	// committed.
+	Event *ChaincodeEvent
+	// Endorsements of the transaction by peers.
+	Endorsements []*Endorsement
+	// ProposalResponsePayload is the serialized proposal response payload signed by the endorsers.
+	ProposalResponsePayload []byte
+	// ProposalHash is the hash of the endorsed transaction proposal, recorded in the proposal response payload.
+	ProposalHash []byte
+}
+
+// Endorsement of a transaction by a peer.
+type Endorsement struct {
+	// MSPID of the organization to which the endorsing peer belongs.
+	MSPID string
+	// Credentials of the endorsing peer, such as a PEM encoded X.509 certificate.
+	Credentials []byte
+	// Endorser is the serialized identity of the endorsing peer.
+	Endorser []byte
+	// Signature of the endorsing peer over the proposal response payload concatenated with the serialized endorser
+	// identity.
+	Signature []byte
+}
+
+// Content parses the prepared transaction to obtain the endorsed transaction content.
+func (transaction *Transaction) Content() (*TransactionContent, error) {
+	parsedTransaction, err := parser.ParseEnvelope(transaction.preparedTransaction.GetEnvelope())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parsedTransaction.Actions) == 0 {
+		return nil, errors.New("no transaction action found")
+	}
+	action := parsedTransaction.Actions[0]
+
+	endorsements, err := newEndorsements(action.Endorsements)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TransactionContent{
+		TransactionID:           parsedTransaction.TransactionID(),
+		ChannelName:             parsedTransaction.ChannelHeader.GetChannelId(),
+		ChaincodeName:           action.ChaincodeName,
+		Status:                  action.Response.GetStatus(),
+		Message:                 action.Response.GetMessage(),
+		Result:                  action.Response.GetPayload(),
+		Event:                   newPendingChaincodeEvent(action.Event),
+		Endorsements:            endorsements,
+		ProposalResponsePayload: action.ProposalResponsePayload,
+		ProposalHash:            action.ProposalHash,
+	}
+
+	if len(action.Arguments) > 0 {
+		result.TransactionName = string(action.Arguments[0])
+		result.Arguments = action.Arguments[1:]
+	}
+
+	return result, nil
+}
+
+func newEndorsements(endorsements []*peer.Endorsement) ([]*Endorsement, error) {
+	results := make([]*Endorsement, 0, len(endorsements))
+	for _, endorsement := range endorsements {
+		endorser := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(endorsement.GetEndorser(), endorser); err != nil {
+			return nil, fmt.Errorf("failed to deserialize endorser identity: %w", err)
+		}
+
+		results = append(results, &Endorsement{
+			MSPID:       endorser.GetMspid(),
+			Credentials: endorser.GetIdBytes(),
+			Endorser:    endorsement.GetEndorser(),
+			Signature:   endorsement.GetSignature(),
+		})
+	}
+
+	return results, nil
+}
+
+func newPendingChaincodeEvent(event *peer.ChaincodeEvent) *ChaincodeEvent {
+	if event == nil {
+		return nil
+	}
+
+	return &ChaincodeEvent{
+		TransactionID: event.GetTxId(),
+		ChaincodeName: event.GetChaincodeId(),
+		EventName:     event.GetEventName(),
+		Payload:       event.GetPayload(),
+	}
+}
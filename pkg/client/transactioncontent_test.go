@@ -0,0 +1,185 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/gateway"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionContent(t *testing.T) {
+	endorser := AssertMarshal(t, &msp.SerializedIdentity{
+		Mspid:   "Org1MSP",
+		IdBytes: []byte("ENDORSER_CREDENTIALS"),
+	})
+	event := &peer.ChaincodeEvent{
+		ChaincodeId: "CHAINCODE",
+		TxId:        "TX_ID",
+		EventName:   "EVENT_NAME",
+		Payload:     []byte("EVENT_PAYLOAD"),
+	}
+
+	newProposalResponsePayload := func(t *testing.T, event *peer.ChaincodeEvent) []byte {
+		chaincodeAction := &peer.ChaincodeAction{
+			Response: &peer.Response{
+				Status:  200,
+				Message: "MESSAGE",
+				Payload: []byte("TRANSACTION_RESULT"),
+			},
+		}
+		if event != nil {
+			chaincodeAction.Events = AssertMarshal(t, event)
+		}
+
+		return AssertMarshal(t, &peer.ProposalResponsePayload{
+			ProposalHash: []byte("PROPOSAL_HASH"),
+			Extension:    AssertMarshal(t, chaincodeAction),
+		})
+	}
+
+	newEndorseResponse := func(t *testing.T, proposalResponsePayload []byte, endorsements ...*peer.Endorsement) *gateway.EndorseResponse {
+		return &gateway.EndorseResponse{
+			PreparedTransaction: &common.Envelope{
+				Payload: AssertMarshal(t, &common.Payload{
+					Header: &common.Header{
+						ChannelHeader: AssertMarshal(t, &common.ChannelHeader{
+							Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+							ChannelId: "CHANNEL",
+							TxId:      "TX_ID",
+						}),
+					},
+					Data: AssertMarshal(t, &peer.Transaction{
+						Actions: []*peer.TransactionAction{
+							{
+								Payload: AssertMarshal(t, &peer.ChaincodeActionPayload{
+									ChaincodeProposalPayload: AssertMarshal(t, &peer.ChaincodeProposalPayload{
+										Input: AssertMarshal(t, &peer.ChaincodeInvocationSpec{
+											ChaincodeSpec: &peer.ChaincodeSpec{
+												ChaincodeId: &peer.ChaincodeID{Name: "CHAINCODE"},
+												Input: &peer.ChaincodeInput{
+													Args: [][]byte{[]byte("CONTRACT:TRANSACTION"), []byte("ONE"), []byte("TWO")},
+												},
+											},
+										}),
+									}),
+									Action: &peer.ChaincodeEndorsedAction{
+										ProposalResponsePayload: proposalResponsePayload,
+										Endorsements:            endorsements,
+									},
+								}),
+							},
+						},
+					}),
+				}),
+			},
+		}
+	}
+
+	newTransaction := func(t *testing.T, endorseResponse *gateway.EndorseResponse) *Transaction {
+		mockConnection := NewMockClientConnInterface(t)
+		ExpectEndorse(mockConnection, WithEndorseResponse(endorseResponse))
+
+		contract := AssertNewTestContract(t, "CHAINCODE", WithClientConnection(mockConnection))
+		transaction, err := contract.NewProposal("TRANSACTION")
+		require.NoError(t, err, "NewProposal")
+
+		result, err := transaction.Endorse()
+		require.NoError(t, err, "Endorse")
+
+		return result
+	}
+
+	t.Run("Includes invocation details", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, nil)))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		require.Equal(t, "TX_ID", actual.TransactionID, "TransactionID")
+		require.Equal(t, "CHANNEL", actual.ChannelName, "ChannelName")
+		require.Equal(t, "CHAINCODE", actual.ChaincodeName, "ChaincodeName")
+		require.Equal(t, "CONTRACT:TRANSACTION", actual.TransactionName, "TransactionName")
+		require.Equal(t, [][]byte{[]byte("ONE"), []byte("TWO")}, actual.Arguments, "Arguments")
+	})
+
+	t.Run("Includes chaincode response", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, nil)))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		require.EqualValues(t, 200, actual.Status, "Status")
+		require.Equal(t, "MESSAGE", actual.Message, "Message")
+		require.Equal(t, []byte("TRANSACTION_RESULT"), actual.Result, "Result")
+		require.Equal(t, transaction.Result(), actual.Result, "Transaction.Result()")
+	})
+
+	t.Run("Includes proposal response payload and hash", func(t *testing.T) {
+		proposalResponsePayload := newProposalResponsePayload(t, nil)
+		transaction := newTransaction(t, newEndorseResponse(t, proposalResponsePayload))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		require.Equal(t, proposalResponsePayload, actual.ProposalResponsePayload, "ProposalResponsePayload")
+		require.Equal(t, []byte("PROPOSAL_HASH"), actual.ProposalHash, "ProposalHash")
+	})
+
+	t.Run("Includes endorsements", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, nil),
+			&peer.Endorsement{Endorser: endorser, Signature: []byte("SIGNATURE")},
+		))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		expected := []*Endorsement{
+			{
+				MSPID:       "Org1MSP",
+				Credentials: []byte("ENDORSER_CREDENTIALS"),
+				Endorser:    endorser,
+				Signature:   []byte("SIGNATURE"),
+			},
+		}
+		require.Equal(t, expected, actual.Endorsements)
+	})
+
+	t.Run("Includes chaincode event", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, event)))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		expected := &ChaincodeEvent{
+			TransactionID: "TX_ID",
+			ChaincodeName: "CHAINCODE",
+			EventName:     "EVENT_NAME",
+			Payload:       []byte("EVENT_PAYLOAD"),
+		}
+		require.Equal(t, expected, actual.Event)
+	})
+
+	t.Run("Nil event if no chaincode event emitted", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, nil)))
+
+		actual, err := transaction.Content()
+		require.NoError(t, err)
+
+		require.Nil(t, actual.Event)
+	})
+
+	t.Run("Fails for invalid endorser identity", func(t *testing.T) {
+		transaction := newTransaction(t, newEndorseResponse(t, newProposalResponsePayload(t, nil),
+			&peer.Endorsement{Endorser: []byte("NOT_AN_IDENTITY"), Signature: []byte("SIGNATURE")},
+		))
+
+		_, err := transaction.Content()
+		require.ErrorContains(t, err, "endorser identity")
+	})
+}
@@ -86,3 +86,57 @@ func parseResultFromTransactionAction(transactionAction *peer.TransactionAction)
 
 	return chaincodeAction.GetResponse().GetPayload(), nil
 }
+
+// parseProposalChaincodeInvocation returns the chaincode and transaction name from a signed proposal, or empty strings
+// if they cannot be determined.
+func parseProposalChaincodeInvocation(signedProposal *peer.SignedProposal) (string, string) {
+	proposal := &peer.Proposal{}
+	if err := proto.Unmarshal(signedProposal.GetProposalBytes(), proposal); err != nil {
+		return "", ""
+	}
+
+	return parseChaincodeInvocation(proposal.GetPayload())
+}
+
+// parseChaincodeInvocationFromEnvelope returns the chaincode and transaction name from a transaction envelope, or
+// empty strings if they cannot be determined.
+func parseChaincodeInvocationFromEnvelope(envelope *common.Envelope) (string, string) {
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.GetPayload(), payload); err != nil {
+		return "", ""
+	}
+
+	transaction := &peer.Transaction{}
+	if err := proto.Unmarshal(payload.GetData(), transaction); err != nil || len(transaction.GetActions()) == 0 {
+		return "", ""
+	}
+
+	actionPayload := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(transaction.GetActions()[0].GetPayload(), actionPayload); err != nil {
+		return "", ""
+	}
+
+	return parseChaincodeInvocation(actionPayload.GetChaincodeProposalPayload())
+}
+
+// parseChaincodeInvocation returns the chaincode and transaction name from a serialized chaincode proposal payload,
+// or empty strings if they cannot be determined.
+func parseChaincodeInvocation(chaincodeProposalPayload []byte) (string, string) {
+	payload := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(chaincodeProposalPayload, payload); err != nil {
+		return "", ""
+	}
+
+	invocationSpec := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(payload.GetInput(), invocationSpec); err != nil {
+		return "", ""
+	}
+
+	chaincodeSpec := invocationSpec.GetChaincodeSpec()
+	var transactionName string
+	if args := chaincodeSpec.GetInput().GetArgs(); len(args) > 0 {
+		transactionName = string(args[0])
+	}
+
+	return chaincodeSpec.GetChaincodeId().GetName(), transactionName
+}
@@ -0,0 +1,417 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
+)
+
+//go:embed client.go.tmpl
+var clientTemplateText string
+
+var clientTemplate = template.Must(template.New("client").Parse(clientTemplateText))
+
+// reservedParameterNames are identifiers used by generated methods that must not be shadowed by parameters.
+var reservedParameterNames = map[string]bool{
+	"c":       true,
+	"client":  true,
+	"context": true,
+	"ctx":     true,
+	"err":     true,
+	"options": true,
+	"result":  true,
+}
+
+// reservedMethodNames are the names of accessor methods on generated clients that must not be used by transaction
+// methods.
+var reservedMethodNames = map[string]bool{
+	"Contract": true,
+}
+
+type generatedFile struct {
+	Package     string
+	Types       []*generatedType
+	Contracts   []*generatedContract
+	UsesContext bool
+}
+
+type generatedType struct {
+	Name        string
+	Description string
+	// Underlying is the type definition for schemas that are not objects.
+	Underlying string
+	Fields     []*generatedField
+}
+
+type generatedField struct {
+	Name      string
+	Type      string
+	JSONName  string
+	OmitEmpty bool
+}
+
+type generatedContract struct {
+	TypeName     string
+	ContractName string
+	Description  string
+	Default      bool
+	Methods      []*generatedMethod
+}
+
+type generatedMethod struct {
+	Name            string
+	TransactionName string
+	Submit          bool
+	Parameters      []*generatedParameter
+	// ResultType is empty if the transaction function does not return a value.
+	ResultType string
+	// IsStringResult is true if the result schema resolves to a string, so the result is returned as a plain string
+	// rather than decoded as JSON.
+	IsStringResult bool
+}
+
+type generatedParameter struct {
+	Name string
+	Type string
+	// IsString is true if the parameter schema resolves to a string, so the parameter is passed as a plain string
+	// argument rather than encoded as JSON.
+	IsString bool
+}
+
+// StringArgument returns the expression used to pass a string parameter as a plain string argument.
+func (parameter *generatedParameter) StringArgument() string {
+	if parameter.Type == "string" {
+		return parameter.Name
+	}
+	return "string(" + parameter.Name + ")"
+}
+
+// generate Go source code for typed clients of the application contracts described by chaincode metadata.
+func generate(chaincodeMetadata *metadata.Metadata, packageName string) ([]byte, error) {
+	file := &generatedFile{
+		Package: packageName,
+	}
+
+	generatedTypes, err := generateTypes(chaincodeMetadata)
+	if err != nil {
+		return nil, err
+	}
+	file.Types = generatedTypes
+
+	for _, contract := range chaincodeMetadata.ApplicationContracts() {
+		generated, err := generateContract(chaincodeMetadata, contract)
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: %w", contract.Name, err)
+		}
+		file.Contracts = append(file.Contracts, generated)
+		file.UsesContext = file.UsesContext || len(generated.Methods) > 0
+	}
+
+	var source bytes.Buffer
+	if err := clientTemplate.Execute(&source, file); err != nil {
+		return nil, fmt.Errorf("failed to generate source: %w", err)
+	}
+
+	result, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+
+	return result, nil
+}
+
+func generateTypes(chaincodeMetadata *metadata.Metadata) ([]*generatedType, error) {
+	names := make([]string, 0, len(chaincodeMetadata.Components.Schemas))
+	for name := range chaincodeMetadata.Components.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	results := make([]*generatedType, 0, len(names))
+	for _, name := range names {
+		generated, err := generateType(name, chaincodeMetadata.Components.Schemas[name])
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+		results = append(results, generated)
+	}
+
+	return results, nil
+}
+
+func generateType(name string, schema *metadata.Schema) (*generatedType, error) {
+	result := &generatedType{
+		Name:        exportedName(name),
+		Description: singleLine(schema.Description),
+	}
+
+	if schema.Type != "object" && len(schema.Properties) == 0 {
+		underlying, err := goType(schema)
+		if err != nil {
+			return nil, err
+		}
+		result.Underlying = underlying
+		return result, nil
+	}
+
+	propertyNames := make([]string, 0, len(schema.Properties))
+	for propertyName := range schema.Properties {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	slices.Sort(propertyNames)
+
+	usedNames := make(map[string]bool)
+	for _, propertyName := range propertyNames {
+		fieldType, err := goType(schema.Properties[propertyName])
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", propertyName, err)
+		}
+
+		result.Fields = append(result.Fields, &generatedField{
+			Name:      uniqueName(exportedName(propertyName), usedNames),
+			Type:      fieldType,
+			JSONName:  propertyName,
+			OmitEmpty: !slices.Contains(schema.Required, propertyName),
+		})
+	}
+
+	return result, nil
+}
+
+func generateContract(chaincodeMetadata *metadata.Metadata, contract *metadata.Contract) (*generatedContract, error) {
+	result := &generatedContract{
+		TypeName:     exportedName(contract.Name) + "Client",
+		ContractName: contract.Name,
+		Default:      contract.Default,
+	}
+	if contract.Info != nil {
+		result.Description = singleLine(contract.Info.Description)
+	}
+
+	usedNames := make(map[string]bool, len(reservedMethodNames))
+	for name := range reservedMethodNames {
+		usedNames[name] = true
+	}
+
+	for _, transaction := range contract.Transactions {
+		method, err := generateMethod(chaincodeMetadata, transaction)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", transaction.Name, err)
+		}
+		method.Name = uniqueName(method.Name, usedNames)
+		result.Methods = append(result.Methods, method)
+	}
+
+	return result, nil
+}
+
+func generateMethod(chaincodeMetadata *metadata.Metadata, transaction *metadata.Transaction) (*generatedMethod, error) {
+	result := &generatedMethod{
+		Name:            exportedName(transaction.Name),
+		TransactionName: transaction.Name,
+		Submit:          transaction.IsSubmit(),
+	}
+
+	usedNames := make(map[string]bool, len(reservedParameterNames))
+	for name := range reservedParameterNames {
+		usedNames[name] = true
+	}
+
+	for _, parameter := range transaction.Parameters {
+		parameterType, err := goType(parameter.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", parameter.Name, err)
+		}
+		if err := checkRef(chaincodeMetadata, parameter.Schema); err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", parameter.Name, err)
+		}
+
+		result.Parameters = append(result.Parameters, &generatedParameter{
+			Name:     uniqueName(unexportedName(parameter.Name), usedNames),
+			Type:     parameterType,
+			IsString: isStringSchema(chaincodeMetadata, parameter.Schema),
+		})
+	}
+
+	if transaction.Returns != nil {
+		resultType, err := goType(transaction.Returns)
+		if err != nil {
+			return nil, fmt.Errorf("returns: %w", err)
+		}
+		if err := checkRef(chaincodeMetadata, transaction.Returns); err != nil {
+			return nil, fmt.Errorf("returns: %w", err)
+		}
+		result.ResultType = resultType
+		result.IsStringResult = isStringSchema(chaincodeMetadata, transaction.Returns)
+	}
+
+	return result, nil
+}
+
+// checkRef checks that any schema reference resolves to a component schema, for which a type is generated.
+func checkRef(chaincodeMetadata *metadata.Metadata, schema *metadata.Schema) error {
+	for schema != nil && schema.Type == "array" {
+		schema = schema.Items
+	}
+	_, err := chaincodeMetadata.Resolve(schema)
+	return err
+}
+
+// isStringSchema reports whether a schema, or the component schema it references, describes string values. Chaincode
+// exchanges string values as plain strings rather than encoded as JSON.
+func isStringSchema(chaincodeMetadata *metadata.Metadata, schema *metadata.Schema) bool {
+	resolved, err := chaincodeMetadata.Resolve(schema)
+	return err == nil && resolved != nil && resolved.Type == "string"
+}
+
+// goType returns the Go type used to represent values described by a schema.
+func goType(schema *metadata.Schema) (string, error) {
+	if schema == nil {
+		return "any", nil
+	}
+
+	if schema.Ref != "" {
+		return refType(schema)
+	}
+
+	switch schema.Type {
+	case "string":
+		return "string", nil
+	case "boolean":
+		return "bool", nil
+	case "integer":
+		return integerType(schema.Format), nil
+	case "number":
+		return numberType(schema.Format), nil
+	case "array":
+		return arrayType(schema)
+	case "object":
+		return objectType(schema)
+	default:
+		return "any", nil
+	}
+}
+
+func refType(schema *metadata.Schema) (string, error) {
+	name, ok := schema.RefName()
+	if !ok {
+		return "", fmt.Errorf("unsupported schema reference: %s", schema.Ref)
+	}
+	return exportedName(name), nil
+}
+
+func numberType(format string) string {
+	if format == "float" {
+		return "float32"
+	}
+	return "float64"
+}
+
+func arrayType(schema *metadata.Schema) (string, error) {
+	itemType, err := goType(schema.Items)
+	if err != nil {
+		return "", err
+	}
+	return "[]" + itemType, nil
+}
+
+// objectType returns a map type for objects that do not have a component schema, with values of the type described
+// by the additional properties schema.
+func objectType(schema *metadata.Schema) (string, error) {
+	if schema.AdditionalProperties == nil || schema.AdditionalProperties.Schema == nil {
+		return "map[string]any", nil
+	}
+
+	valueType, err := goType(schema.AdditionalProperties.Schema)
+	if err != nil {
+		return "", err
+	}
+	return "map[string]" + valueType, nil
+}
+
+func integerType(format string) string {
+	switch format {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		return format
+	default:
+		return "int64"
+	}
+}
+
+// exportedName converts a metadata name into an exported Go identifier by removing characters that are not valid in
+// identifiers and capitalizing the first letter of each word.
+func exportedName(name string) string {
+	var result strings.Builder
+	capitalize := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			capitalize = true
+			continue
+		}
+		if capitalize {
+			r = unicode.ToUpper(r)
+			capitalize = false
+		}
+		result.WriteRune(r)
+	}
+
+	identifier := result.String()
+	if identifier == "" || !unicode.IsLetter([]rune(identifier)[0]) {
+		identifier = "X" + identifier
+	}
+
+	return identifier
+}
+
+// unexportedName converts a metadata name into an unexported Go identifier that is not a Go keyword or predeclared
+// identifier.
+func unexportedName(name string) string {
+	runes := []rune(exportedName(name))
+
+	// Lower case the leading upper case run, leaving the start of the next word capitalized; for example, IDValue
+	// becomes idValue.
+	end := 1
+	for end < len(runes) && unicode.IsUpper(runes[end]) {
+		end++
+	}
+	if end > 1 && end < len(runes) {
+		end--
+	}
+	for i := range end {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	identifier := string(runes)
+	if token.IsKeyword(identifier) || types.Universe.Lookup(identifier) != nil {
+		identifier += "Arg"
+	}
+
+	return identifier
+}
+
+// uniqueName returns the name, or the name with a numeric suffix if the name is already used, and records it as used.
+func uniqueName(name string, used map[string]bool) string {
+	result := name
+	for i := 2; used[result]; i++ {
+		result = fmt.Sprintf("%s%d", name, i)
+	}
+	used[result] = true
+
+	return result
+}
+
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
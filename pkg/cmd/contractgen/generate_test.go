@@ -0,0 +1,271 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func assertGenerate(t *testing.T, chaincodeMetadata *metadata.Metadata) string {
+	source, err := generate(chaincodeMetadata, "assets")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "client.go", source, parser.AllErrors)
+	require.NoError(t, err, "generated source:\n%s", source)
+
+	return string(source)
+}
+
+// assertTypeCheck checks that generated source compiles, which parsing alone does not detect.
+func assertTypeCheck(t *testing.T, source string) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "client.go", source, parser.AllErrors)
+	require.NoError(t, err)
+
+	config := &types.Config{
+		Importer: importer.ForCompiler(fileSet, "gc", exportDataLookup(t)),
+	}
+	_, err = config.Check("assets", fileSet, []*ast.File{file}, nil)
+	require.NoError(t, err, "generated source:\n%s", source)
+}
+
+// exportDataLookup locates compiler export data for the client package and its dependencies, which is much faster
+// than type checking them from source.
+func exportDataLookup(t *testing.T) importer.Lookup {
+	output, err := exec.Command("go", "list", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}",
+		"github.com/hyperledger/fabric-gateway/pkg/client").Output()
+	require.NoError(t, err, "go list")
+
+	exportFiles := make(map[string]string)
+	for line := range strings.Lines(string(output)) {
+		importPath, exportFile, _ := strings.Cut(strings.TrimSpace(line), "=")
+		exportFiles[importPath] = exportFile
+	}
+
+	return func(importPath string) (io.ReadCloser, error) {
+		return os.Open(exportFiles[importPath])
+	}
+}
+
+func assertLoadMetadata(t *testing.T) *metadata.Metadata {
+	data, err := os.ReadFile(filepath.Join("testdata", "metadata.json"))
+	require.NoError(t, err)
+
+	result, err := metadata.Parse(data)
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("Generates types for component schemas", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "type Asset struct {")
+		require.Regexp(t, "AppraisedValue +int64 +`json:\"AppraisedValue\"`", source)
+	})
+
+	t.Run("Generates client for default contract", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "func NewSmartContractClient(network *client.Network, chaincodeName string) *SmartContractClient {")
+		require.Contains(t, source, "contract: network.GetContract(chaincodeName),")
+	})
+
+	t.Run("Excludes system contract", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.NotContains(t, source, "GetMetadata")
+	})
+
+	t.Run("Submits transactions tagged for submit", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "func (c *SmartContractClient) CreateAsset(ctx context.Context, id string, color string, size int64, owner string, appraisedValue int64, options ...client.ProposalOption) error {")
+		require.Contains(t, source, `c.contract.SubmitWithContext(ctx, "CreateAsset", options...)`)
+	})
+
+	t.Run("Evaluates transactions tagged for evaluate", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "func (c *SmartContractClient) ReadAsset(ctx context.Context, id string, options ...client.ProposalOption) (Asset, error) {")
+		require.Contains(t, source, `client.EvaluateAsWithContext[Asset](ctx, c.contract, client.JSONCodec, "ReadAsset", options...)`)
+	})
+
+	t.Run("Encodes non-string arguments as JSON", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "client.WithArguments(id),")
+		require.Contains(t, source, "client.WithTypedArguments(client.JSONCodec, size),")
+		require.Contains(t, source, "client.WithTypedArguments(client.JSONCodec, assets),")
+	})
+
+	t.Run("Returns string results without decoding", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "func (c *SmartContractClient) TransferAsset(ctx context.Context, id string, newOwner string, options ...client.ProposalOption) (string, error) {")
+		require.Contains(t, source, "return string(result), nil")
+	})
+
+	t.Run("Passes referenced string schemas as plain strings", func(t *testing.T) {
+		chaincodeMetadata := &metadata.Metadata{
+			Contracts: map[string]*metadata.Contract{
+				"Contract": {
+					Name: "Contract",
+					Transactions: []*metadata.Transaction{
+						{
+							Name: "Paint",
+							Parameters: []*metadata.Parameter{
+								{Name: "color", Schema: &metadata.Schema{Ref: "#/components/schemas/Color"}},
+							},
+							Returns: &metadata.Schema{Ref: "#/components/schemas/Color"},
+						},
+					},
+				},
+			},
+			Components: metadata.Components{
+				Schemas: map[string]*metadata.Schema{
+					"Color": {Type: "string", Enum: []any{"red", "blue"}},
+				},
+			},
+		}
+
+		source := assertGenerate(t, chaincodeMetadata)
+
+		require.Contains(t, source, "type Color string")
+		require.Contains(t, source, "Paint(ctx context.Context, color Color, options ...client.ProposalOption) (Color, error) {")
+		require.Contains(t, source, "client.WithArguments(string(color)),")
+		require.Contains(t, source, "return Color(result), nil")
+		require.NotContains(t, source, "client.JSONCodec")
+	})
+
+	t.Run("Decodes array results", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		require.Contains(t, source, "client.EvaluateAsWithContext[[]Asset](")
+	})
+
+	t.Run("Uses qualified contract name for non-default contract", func(t *testing.T) {
+		chaincodeMetadata := &metadata.Metadata{
+			Contracts: map[string]*metadata.Contract{
+				"org.example.assets": {
+					Name: "org.example.assets",
+					Transactions: []*metadata.Transaction{
+						{Name: "Ping"},
+					},
+				},
+			},
+		}
+
+		source := assertGenerate(t, chaincodeMetadata)
+
+		require.Contains(t, source, "type OrgExampleAssetsClient struct {")
+		require.Contains(t, source, `contract: network.GetContractWithName(chaincodeName, "org.example.assets"),`)
+	})
+
+	t.Run("Renames parameters that clash with Go identifiers", func(t *testing.T) {
+		chaincodeMetadata := &metadata.Metadata{
+			Contracts: map[string]*metadata.Contract{
+				"Contract": {
+					Name: "Contract",
+					Transactions: []*metadata.Transaction{
+						{
+							Name: "Clash",
+							Parameters: []*metadata.Parameter{
+								{Name: "type", Schema: &metadata.Schema{Type: "string"}},
+								{Name: "string", Schema: &metadata.Schema{Type: "string"}},
+								{Name: "ctx", Schema: &metadata.Schema{Type: "string"}},
+								{Name: "options", Schema: &metadata.Schema{Type: "string"}},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		source := assertGenerate(t, chaincodeMetadata)
+
+		require.Contains(t, source, "Clash(ctx context.Context, typeArg string, stringArg string, ctx2 string, options2 string, options ...client.ProposalOption) error {")
+	})
+
+	t.Run("Generated source compiles", func(t *testing.T) {
+		source := assertGenerate(t, assertLoadMetadata(t))
+
+		assertTypeCheck(t, source)
+	})
+
+	t.Run("Renames methods that clash with client accessors", func(t *testing.T) {
+		chaincodeMetadata := &metadata.Metadata{
+			Contracts: map[string]*metadata.Contract{
+				"Contract": {
+					Name: "Contract",
+					Transactions: []*metadata.Transaction{
+						{Name: "Contract"},
+						{Name: "contract"},
+					},
+				},
+			},
+		}
+
+		source := assertGenerate(t, chaincodeMetadata)
+
+		require.Contains(t, source, "func (c *ContractClient) Contract2(ctx context.Context, options ...client.ProposalOption) error {")
+		require.Contains(t, source, "func (c *ContractClient) Contract3(ctx context.Context, options ...client.ProposalOption) error {")
+		assertTypeCheck(t, source)
+	})
+
+	t.Run("Fails for unresolved schema reference", func(t *testing.T) {
+		chaincodeMetadata := &metadata.Metadata{
+			Contracts: map[string]*metadata.Contract{
+				"Contract": {
+					Name: "Contract",
+					Transactions: []*metadata.Transaction{
+						{Name: "Read", Returns: &metadata.Schema{Ref: "#/components/schemas/Missing"}},
+					},
+				},
+			},
+		}
+
+		_, err := generate(chaincodeMetadata, "assets")
+		require.ErrorContains(t, err, "Missing")
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Writes generated source to output file", func(t *testing.T) {
+		outputFile := filepath.Join(t.TempDir(), "client.go")
+
+		err := run([]string{"--metadata", filepath.Join("testdata", "metadata.json"), "--package", "assets", "--output", outputFile})
+		require.NoError(t, err)
+
+		source, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		require.Contains(t, string(source), "package assets")
+	})
+
+	t.Run("Fails without metadata source", func(t *testing.T) {
+		err := run([]string{"--package", "assets"})
+		require.Error(t, err)
+	})
+
+	t.Run("Fails without package name", func(t *testing.T) {
+		t.Setenv("GOPACKAGE", "")
+
+		err := run([]string{"--metadata", filepath.Join("testdata", "metadata.json")})
+		require.ErrorContains(t, err, "package")
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Contractgen generates strongly typed Go clients for smart contracts from the metadata exposed by chaincode
+// implemented using a Fabric contract API. Each generated client wraps a [client.Contract], and provides a method for
+// each transaction function that takes typed parameters, returns a typed result, and submits or evaluates the
+// transaction function as indicated by the metadata. Types are also generated for the JSON schemas defined by the
+// metadata.
+//
+// Metadata may be read from a JSON file, such as one saved from the result of the org.hyperledger.fabric:GetMetadata
+// transaction function:
+//
+//	contractgen --metadata metadata.json --output assets_client.go
+//
+// Alternatively, metadata may be obtained from deployed chaincode using a Gateway connection described by a
+// configuration file, as used by the [config] package:
+//
+//	contractgen --config gateway.yaml --channel mychannel --chaincode basic --output assets_client.go
+//
+// Contractgen is intended to be run using go generate:
+//
+//	//go:generate go run github.com/hyperledger/fabric-gateway/pkg/cmd/contractgen --metadata metadata.json --output assets_client.go
+//
+// The package name of the generated code defaults to the package in which go generate is run, and can be specified
+// using the --package flag.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/config"
+	"github.com/hyperledger/fabric-gateway/pkg/metadata"
+	flag "github.com/spf13/pflag"
+)
+
+const fetchTimeout = 30 * time.Second
+
+type options struct {
+	metadataFile  string
+	configFile    string
+	channelName   string
+	chaincodeName string
+	packageName   string
+	outputFile    string
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "contractgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	opts, err := parseFlags(args)
+	if err != nil {
+		return err
+	}
+
+	chaincodeMetadata, err := readMetadata(opts)
+	if err != nil {
+		return err
+	}
+
+	source, err := generate(chaincodeMetadata, opts.packageName)
+	if err != nil {
+		return err
+	}
+
+	if opts.outputFile == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return os.WriteFile(opts.outputFile, source, 0644) //#nosec G306 -- Generated source files are not sensitive
+}
+
+func parseFlags(args []string) (*options, error) {
+	opts := &options{}
+
+	flags := flag.NewFlagSet("contractgen", flag.ContinueOnError)
+	flags.StringVar(&opts.metadataFile, "metadata", "", "chaincode metadata JSON file")
+	flags.StringVar(&opts.configFile, "config", "", "Gateway configuration file used to obtain metadata from chaincode")
+	flags.StringVar(&opts.channelName, "channel", "", "channel name used to obtain metadata from chaincode")
+	flags.StringVar(&opts.chaincodeName, "chaincode", "", "chaincode name used to obtain metadata from chaincode")
+	flags.StringVar(&opts.packageName, "package", os.Getenv("GOPACKAGE"), "package name of the generated code")
+	flags.StringVarP(&opts.outputFile, "output", "o", "", "output file (default standard output)")
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return opts, opts.validate()
+}
+
+func (opts *options) validate() error {
+	if opts.packageName == "" {
+		return errors.New("package name not specified")
+	}
+
+	if opts.metadataFile != "" {
+		if opts.configFile != "" {
+			return errors.New("only one of --metadata or --config may be specified")
+		}
+		return nil
+	}
+
+	if opts.configFile == "" || opts.channelName == "" || opts.chaincodeName == "" {
+		return errors.New("either --metadata, or --config with --channel and --chaincode, must be specified")
+	}
+
+	return nil
+}
+
+func readMetadata(opts *options) (*metadata.Metadata, error) {
+	if opts.metadataFile == "" {
+		return fetchMetadata(opts.configFile, opts.channelName, opts.chaincodeName)
+	}
+
+	data, err := os.ReadFile(opts.metadataFile) //#nosec G304 -- Caller responsible for safe file name
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata file: %w", err)
+	}
+
+	return metadata.Parse(data)
+}
+
+// fetchMetadata obtains metadata from deployed chaincode.
+func fetchMetadata(configFile string, channelName string, chaincodeName string) (*metadata.Metadata, error) {
+	gateway, closeGateway, err := config.ConnectFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = closeGateway()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	result, err := gateway.GetNetwork(channelName).GetContract(chaincodeName).MetadataWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain metadata from chaincode: %w", err)
+	}
+
+	return result, nil
+}
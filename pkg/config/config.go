@@ -0,0 +1,195 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config connects a [client.Gateway] using connection details read from a declarative configuration file,
+// avoiding boilerplate code to load credentials, create a gRPC connection and apply connect options.
+//
+// Configuration files may be YAML or JSON. Relative file paths in a configuration file are resolved relative to the
+// directory containing the configuration file. An example YAML configuration is:
+//
+//	peer:
+//	  endpoint: peer0.org1.example.com:7051
+//	  tlsCACert: tls/ca.crt
+//	  clientTLSCert: tls/client.crt
+//	  clientTLSKey: tls/client.key
+//	identity:
+//	  mspID: Org1MSP
+//	  cert: msp/signcerts/cert.pem
+//	  key: msp/keystore/key.pem
+//	hash: SHA256
+//	timeouts:
+//	  evaluate: 5s
+//	  endorse: 15s
+//	  submit: 5s
+//	  commitStatus: 1m
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/hash"
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes the connection of a client identity to a Fabric Gateway.
+type Config struct {
+	// Peer is the Gateway peer connection.
+	Peer Peer `yaml:"peer"`
+	// Identity is the client identity and its signing credentials.
+	Identity Identity `yaml:"identity"`
+	// Hash is the name of the hash algorithm used to generate message digests for signing. Valid values are SHA256,
+	// SHA384, SHA3_256, SHA3_384 and NONE. If not specified, the client API default is used.
+	Hash string `yaml:"hash"`
+	// Timeouts are the default timeouts for Gateway operations. Zero values use the client API defaults.
+	Timeouts Timeouts `yaml:"timeouts"`
+}
+
+// Peer describes the gRPC connection to a Gateway peer.
+type Peer struct {
+	// Endpoint is the address of the Gateway peer, in host:port form.
+	Endpoint string `yaml:"endpoint"`
+	// TLSCACert is the path of a PEM file containing the CA certificates used to verify the Gateway peer's TLS
+	// certificate. If not specified, the connection does not use TLS.
+	TLSCACert string `yaml:"tlsCACert"`
+	// ServerNameOverride, if specified, is the host name used to verify the Gateway peer's TLS certificate instead of
+	// the host name in the endpoint.
+	ServerNameOverride string `yaml:"serverNameOverride"`
+	// ClientTLSCert is the path of a PEM file containing the client certificate used for mutual TLS.
+	ClientTLSCert string `yaml:"clientTLSCert"`
+	// ClientTLSKey is the path of a PEM file containing the client private key used for mutual TLS.
+	ClientTLSKey string `yaml:"clientTLSKey"`
+}
+
+// Identity describes a client X.509 identity and its signing credentials. Exactly one of Key or HSM must be specified.
+type Identity struct {
+	// MSPID is the ID of the member services provider to which the identity belongs.
+	MSPID string `yaml:"mspID"`
+	// Cert is the path of a PEM file containing the identity's X.509 certificate.
+	Cert string `yaml:"cert"`
+	// Key is the path of a PEM file containing the identity's private key.
+	Key string `yaml:"key"`
+	// HSM describes a private key stored in a Hardware Security Module. Using an HSM requires the pkcs11 build tag.
+	HSM *HSM `yaml:"hsm"`
+}
+
+// HSM describes a private key stored in a Hardware Security Module.
+type HSM struct {
+	// Library is the path of the PKCS#11 library.
+	Library string `yaml:"library"`
+	// Label of the HSM token.
+	Label string `yaml:"label"`
+	// Pin used to log in to the HSM token.
+	Pin string `yaml:"pin"`
+	// Identifier of the private key, which is the Subject Key Identifier of the identity's certificate.
+	Identifier string `yaml:"identifier"`
+	// UserType used to log in to the HSM token. Zero is the security officer; one is a normal user.
+	UserType int `yaml:"userType"`
+}
+
+// Timeouts are default timeouts for Gateway operations, specified as Go duration strings such as "30s".
+type Timeouts struct {
+	Evaluate     time.Duration `yaml:"evaluate"`
+	Endorse      time.Duration `yaml:"endorse"`
+	Submit       time.Duration `yaml:"submit"`
+	CommitStatus time.Duration `yaml:"commitStatus"`
+}
+
+// Load reads a YAML or JSON configuration file. Relative file paths in the configuration are resolved relative to the
+// directory containing the configuration file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //#nosec G304 -- Caller responsible for safe file name
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	config, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	config.resolvePaths(filepath.Dir(path))
+
+	return config, nil
+}
+
+// Parse YAML or JSON configuration data. Relative file paths in the configuration are resolved relative to the current
+// working directory.
+func Parse(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// Validate checks that all required configuration values are specified and valid.
+func (config *Config) Validate() error {
+	var errs []error
+
+	if config.Peer.Endpoint == "" {
+		errs = append(errs, errors.New("peer endpoint not specified"))
+	}
+	if (config.Peer.ClientTLSCert == "") != (config.Peer.ClientTLSKey == "") {
+		errs = append(errs, errors.New("client TLS certificate and key must be specified together"))
+	}
+	if config.Identity.MSPID == "" {
+		errs = append(errs, errors.New("identity MSP ID not specified"))
+	}
+	if config.Identity.Cert == "" {
+		errs = append(errs, errors.New("identity certificate not specified"))
+	}
+	if (config.Identity.Key == "") == (config.Identity.HSM == nil) {
+		errs = append(errs, errors.New("exactly one of identity key or HSM must be specified"))
+	}
+	if _, err := hashFunction(config.Hash); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (config *Config) resolvePaths(dir string) {
+	for _, path := range []*string{
+		&config.Peer.TLSCACert,
+		&config.Peer.ClientTLSCert,
+		&config.Peer.ClientTLSKey,
+		&config.Identity.Cert,
+		&config.Identity.Key,
+	} {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(dir, *path)
+		}
+	}
+}
+
+func hashFunction(name string) (hash.Hash, error) {
+	switch name {
+	case "":
+		return nil, nil
+	case "SHA256":
+		return hash.SHA256, nil
+	case "SHA384":
+		return hash.SHA384, nil
+	case "SHA3_256":
+		return hash.SHA3_256, nil
+	case "SHA3_384":
+		return hash.SHA3_384, nil
+	case "NONE":
+		return hash.NONE, nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", name)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-gateway/pkg/hash"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"github.com/hyperledger/fabric-gateway/pkg/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+type credentialFiles struct {
+	dir            string
+	certificatePEM []byte
+}
+
+func newCredentialFiles(t *testing.T) *credentialFiles {
+	privateKey, err := test.NewECDSAPrivateKey()
+	require.NoError(t, err)
+
+	certificate, err := test.NewCertificate(privateKey)
+	require.NoError(t, err)
+
+	certificatePEM, err := identity.CertificateToPEM(certificate)
+	require.NoError(t, err)
+
+	privateKeyPEM, err := identity.PrivateKeyToPEM(privateKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "cert.pem"), certificatePEM)
+	writeFile(t, filepath.Join(dir, "key.pem"), privateKeyPEM)
+
+	return &credentialFiles{
+		dir:            dir,
+		certificatePEM: certificatePEM,
+	}
+}
+
+func writeFile(t *testing.T, name string, data []byte) {
+	require.NoError(t, os.WriteFile(name, data, 0600))
+}
+
+func TestConfig(t *testing.T) {
+	t.Run("Parse YAML", func(t *testing.T) {
+		data := []byte(`
+peer:
+  endpoint: peer.example.org:7051
+  tlsCACert: ca.pem
+  serverNameOverride: peer0
+identity:
+  mspID: Org1MSP
+  cert: cert.pem
+  key: key.pem
+hash: SHA384
+timeouts:
+  evaluate: 5s
+  commitStatus: 1m
+`)
+
+		actual, err := Parse(data)
+		require.NoError(t, err)
+
+		expected := &Config{
+			Peer: Peer{
+				Endpoint:           "peer.example.org:7051",
+				TLSCACert:          "ca.pem",
+				ServerNameOverride: "peer0",
+			},
+			Identity: Identity{
+				MSPID: "Org1MSP",
+				Cert:  "cert.pem",
+				Key:   "key.pem",
+			},
+			Hash: "SHA384",
+			Timeouts: Timeouts{
+				Evaluate:     5 * time.Second,
+				CommitStatus: time.Minute,
+			},
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Parse JSON", func(t *testing.T) {
+		data := []byte(`{
+			"peer": {"endpoint": "peer.example.org:7051"},
+			"identity": {
+				"mspID": "Org1MSP",
+				"cert": "cert.pem",
+				"hsm": {"library": "libsofthsm2.so", "label": "LABEL", "pin": "PIN", "identifier": "ID", "userType": 1}
+			},
+			"timeouts": {"endorse": "30s"}
+		}`)
+
+		actual, err := Parse(data)
+		require.NoError(t, err)
+
+		require.Equal(t, &HSM{Library: "libsofthsm2.so", Label: "LABEL", Pin: "PIN", Identifier: "ID", UserType: 1}, actual.Identity.HSM)
+		require.Equal(t, 30*time.Second, actual.Timeouts.Endorse)
+	})
+
+	t.Run("Parse fails for invalid configuration", func(t *testing.T) {
+		for name, data := range map[string]string{
+			"missing endpoint":      "identity: {mspID: Org1MSP, cert: cert.pem, key: key.pem}",
+			"missing MSP ID":        "peer: {endpoint: peer:7051}\nidentity: {cert: cert.pem, key: key.pem}",
+			"missing certificate":   "peer: {endpoint: peer:7051}\nidentity: {mspID: Org1MSP, key: key.pem}",
+			"missing key":           "peer: {endpoint: peer:7051}\nidentity: {mspID: Org1MSP, cert: cert.pem}",
+			"key and HSM":           "peer: {endpoint: peer:7051}\nidentity: {mspID: Org1MSP, cert: cert.pem, key: key.pem, hsm: {label: L}}",
+			"client TLS cert only":  "peer: {endpoint: peer:7051, clientTLSCert: tls.pem}\nidentity: {mspID: Org1MSP, cert: cert.pem, key: key.pem}",
+			"unsupported hash":      "peer: {endpoint: peer:7051}\nidentity: {mspID: Org1MSP, cert: cert.pem, key: key.pem}\nhash: MD5",
+			"invalid timeout":       "peer: {endpoint: peer:7051}\nidentity: {mspID: Org1MSP, cert: cert.pem, key: key.pem}\ntimeouts: {submit: soon}",
+			"malformed YAML syntax": "peer: [",
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := Parse([]byte(data))
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("Load resolves relative paths against configuration file directory", func(t *testing.T) {
+		dir := t.TempDir()
+		configFile := filepath.Join(dir, "gateway.yaml")
+		writeFile(t, configFile, []byte(`
+peer:
+  endpoint: peer.example.org:7051
+  tlsCACert: tls/ca.pem
+identity:
+  mspID: Org1MSP
+  cert: /absolute/cert.pem
+  key: msp/key.pem
+`))
+
+		actual, err := Load(configFile)
+		require.NoError(t, err)
+
+		require.Equal(t, filepath.Join(dir, "tls", "ca.pem"), actual.Peer.TLSCACert)
+		require.Equal(t, "/absolute/cert.pem", actual.Identity.Cert)
+		require.Equal(t, filepath.Join(dir, "msp", "key.pem"), actual.Identity.Key)
+	})
+
+	t.Run("Load fails for missing file", func(t *testing.T) {
+		_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestConnect(t *testing.T) {
+	credentials := newCredentialFiles(t)
+
+	newConfig := func() *Config {
+		return &Config{
+			Peer: Peer{
+				Endpoint: "peer.example.org:7051",
+			},
+			Identity: Identity{
+				MSPID: "Org1MSP",
+				Cert:  filepath.Join(credentials.dir, "cert.pem"),
+				Key:   filepath.Join(credentials.dir, "key.pem"),
+			},
+		}
+	}
+
+	t.Run("Gateway uses configured identity", func(t *testing.T) {
+		gateway, closeGateway, err := Connect(newConfig())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, closeGateway())
+		}()
+
+		id := gateway.Identity()
+		require.Equal(t, "Org1MSP", id.MspID())
+
+		require.Equal(t, credentials.certificatePEM, id.Credentials())
+	})
+
+	t.Run("Gateway uses configured hash", func(t *testing.T) {
+		config := newConfig()
+		config.Hash = "SHA256"
+
+		gateway, closeGateway, err := Connect(config)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, closeGateway())
+		}()
+
+		proposal, err := gateway.GetNetwork("CHANNEL").GetContract("CHAINCODE").NewProposal("TRANSACTION")
+		require.NoError(t, err)
+
+		require.Len(t, proposal.Digest(), len(hash.SHA256(nil)))
+	})
+
+	t.Run("Connect with mutual TLS", func(t *testing.T) {
+		config := newConfig()
+		config.Peer.TLSCACert = filepath.Join(credentials.dir, "cert.pem")
+		config.Peer.ClientTLSCert = filepath.Join(credentials.dir, "cert.pem")
+		config.Peer.ClientTLSKey = filepath.Join(credentials.dir, "key.pem")
+
+		_, closeGateway, err := Connect(config)
+		require.NoError(t, err)
+		require.NoError(t, closeGateway())
+	})
+
+	t.Run("Connect fails for invalid TLS CA certificate", func(t *testing.T) {
+		config := newConfig()
+		config.Peer.TLSCACert = filepath.Join(credentials.dir, "key.pem")
+
+		_, _, err := Connect(config)
+		require.ErrorContains(t, err, "TLS CA")
+	})
+
+	t.Run("Connect fails for mismatched client TLS certificate and key", func(t *testing.T) {
+		otherCredentials := newCredentialFiles(t)
+		config := newConfig()
+		config.Peer.TLSCACert = filepath.Join(credentials.dir, "cert.pem")
+		config.Peer.ClientTLSCert = filepath.Join(credentials.dir, "cert.pem")
+		config.Peer.ClientTLSKey = filepath.Join(otherCredentials.dir, "key.pem")
+
+		_, _, err := Connect(config)
+		require.Error(t, err)
+	})
+
+	t.Run("Connect fails for mutual TLS without TLS CA certificate", func(t *testing.T) {
+		config := newConfig()
+		config.Peer.ClientTLSCert = filepath.Join(credentials.dir, "cert.pem")
+		config.Peer.ClientTLSKey = filepath.Join(credentials.dir, "key.pem")
+
+		_, _, err := Connect(config)
+		require.ErrorContains(t, err, "TLS CA")
+	})
+
+	t.Run("Connect fails for missing identity certificate", func(t *testing.T) {
+		config := newConfig()
+		config.Identity.Cert = filepath.Join(credentials.dir, "missing.pem")
+
+		_, _, err := Connect(config)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Connect fails for invalid private key", func(t *testing.T) {
+		config := newConfig()
+		config.Identity.Key = filepath.Join(credentials.dir, "cert.pem")
+
+		_, _, err := Connect(config)
+		require.Error(t, err)
+	})
+
+	t.Run("ConnectFile uses configuration file", func(t *testing.T) {
+		configFile := filepath.Join(credentials.dir, "gateway.json")
+		writeFile(t, configFile, []byte(`{
+			"peer": {"endpoint": "peer.example.org:7051"},
+			"identity": {"mspID": "Org2MSP", "cert": "cert.pem", "key": "key.pem"}
+		}`))
+
+		gateway, closeGateway, err := ConnectFile(configFile)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, closeGateway())
+		}()
+
+		require.Equal(t, "Org2MSP", gateway.Identity().MspID())
+	})
+}
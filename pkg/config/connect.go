@@ -0,0 +1,250 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CloseFunc releases all resources associated with a Gateway connected using configuration, including the gRPC
+// connection and any HSM session.
+type CloseFunc = func() error
+
+// ConnectFile connects a Gateway using configuration read from a YAML or JSON file. See [Connect] for details.
+func ConnectFile(path string, options ...client.ConnectOption) (*client.Gateway, CloseFunc, error) {
+	config, err := Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return Connect(config, options...)
+}
+
+// Connect a Gateway using the supplied configuration. Additional connect options are applied after those derived from
+// the configuration, and so take precedence. The returned close function must be called when the Gateway is no longer
+// needed to release resources, including the gRPC connection created by this function.
+func Connect(config *Config, options ...client.ConnectOption) (*client.Gateway, CloseFunc, error) {
+	if err := config.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	closer := &closer{}
+
+	gateway, err := connect(config, closer, options)
+	if err != nil {
+		_ = closer.Close()
+		return nil, nil, err
+	}
+
+	closer.add(gateway.Close)
+	return gateway, closer.Close, nil
+}
+
+func connect(config *Config, closer *closer, options []client.ConnectOption) (*client.Gateway, error) {
+	id, err := newIdentity(&config.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	sign, err := newSign(&config.Identity, closer)
+	if err != nil {
+		return nil, err
+	}
+
+	connectOptions, err := config.connectOptions(closer)
+	if err != nil {
+		return nil, err
+	}
+
+	connectOptions = append(connectOptions, client.WithSign(sign))
+	connectOptions = append(connectOptions, options...)
+
+	return client.Connect(id, connectOptions...)
+}
+
+func (config *Config) connectOptions(closer *closer) ([]client.ConnectOption, error) {
+	connectionOption, closeConnection, err := newClientConnection(&config.Peer)
+	if err != nil {
+		return nil, err
+	}
+	closer.add(closeConnection)
+
+	results := []client.ConnectOption{connectionOption}
+
+	hash, err := hashFunction(config.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if hash != nil {
+		results = append(results, client.WithHash(hash))
+	}
+
+	return append(results, config.Timeouts.connectOptions()...), nil
+}
+
+func (timeouts *Timeouts) connectOptions() []client.ConnectOption {
+	var results []client.ConnectOption
+	if timeouts.Evaluate > 0 {
+		results = append(results, client.WithEvaluateTimeout(timeouts.Evaluate))
+	}
+	if timeouts.Endorse > 0 {
+		results = append(results, client.WithEndorseTimeout(timeouts.Endorse))
+	}
+	if timeouts.Submit > 0 {
+		results = append(results, client.WithSubmitTimeout(timeouts.Submit))
+	}
+	if timeouts.CommitStatus > 0 {
+		results = append(results, client.WithCommitStatusTimeout(timeouts.CommitStatus))
+	}
+	return results
+}
+
+func newIdentity(config *Identity) (*identity.X509Identity, error) {
+	certificatePEM, err := readFile(config.Cert, "identity certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := identity.CertificateFromPEM(certificatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse identity certificate: %w", err)
+	}
+
+	return identity.NewX509Identity(config.MSPID, certificate)
+}
+
+func newSign(config *Identity, closer *closer) (identity.Sign, error) {
+	if config.HSM != nil {
+		return newHSMSign(config.HSM, closer)
+	}
+
+	privateKeyPEM, err := readFile(config.Key, "identity private key")
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := identity.PrivateKeyFromPEM(privateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse identity private key: %w", err)
+	}
+
+	return identity.NewPrivateKeySign(privateKey)
+}
+
+// newClientConnection creates a gRPC connection to the Gateway peer, and returns a connect option that uses it along
+// with a function to close it.
+func newClientConnection(config *Peer) (client.ConnectOption, CloseFunc, error) {
+	if config.ClientTLSCert != "" {
+		mtlsCredentials, err := newMutualTLSCredentials(config)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		connection, err := client.NewMutualTLSClientConnection(config.Endpoint, *mtlsCredentials)
+		if err != nil {
+			return nil, nil, err
+		}
+		return client.WithMutualTLSClientConnection(connection), connection.Close, nil
+	}
+
+	transportCredentials, err := newTransportCredentials(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connection, err := grpc.NewClient(config.Endpoint, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create gRPC connection: %w", err)
+	}
+	return client.WithClientConnection(connection), connection.Close, nil
+}
+
+func newTransportCredentials(config *Peer) (credentials.TransportCredentials, error) {
+	if config.TLSCACert == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	caPEM, err := readFile(config.TLSCACert, "TLS CA certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("no valid TLS CA certificates found in " + config.TLSCACert)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+		ServerName: config.ServerNameOverride,
+	}), nil
+}
+
+func newMutualTLSCredentials(config *Peer) (*client.MutualTLSCredentials, error) {
+	if config.TLSCACert == "" {
+		return nil, errors.New("TLS CA certificate must be specified for mutual TLS")
+	}
+
+	caPEM, err := readFile(config.TLSCACert, "TLS CA certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	certificatePEM, err := readFile(config.ClientTLSCert, "client TLS certificate")
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := readFile(config.ClientTLSKey, "client TLS private key")
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.MutualTLSCredentials{
+		CACertificatesPEM:  caPEM,
+		CertificatePEM:     certificatePEM,
+		PrivateKeyPEM:      privateKeyPEM,
+		ServerNameOverride: config.ServerNameOverride,
+	}, nil
+}
+
+func readFile(name string, description string) ([]byte, error) {
+	data, err := os.ReadFile(name) //#nosec G304 -- Caller responsible for safe file name
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", description, err)
+	}
+	return data, nil
+}
+
+// closer accumulates close functions, which are invoked in reverse order.
+type closer struct {
+	closeFuncs []CloseFunc
+}
+
+func (c *closer) add(closeFunc CloseFunc) {
+	c.closeFuncs = append(c.closeFuncs, closeFunc)
+}
+
+func (c *closer) Close() error {
+	var errs []error
+	for i := len(c.closeFuncs) - 1; i >= 0; i-- {
+		if err := c.closeFuncs[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.closeFuncs = nil
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,16 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !pkcs11
+
+package config
+
+import (
+	"errors"
+
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+)
+
+func newHSMSign(*HSM, *closer) (identity.Sign, error) {
+	return nil, errors.New("HSM signing requires the pkcs11 build tag")
+}
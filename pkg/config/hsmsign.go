@@ -0,0 +1,38 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build pkcs11
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-gateway/pkg/identity"
+)
+
+// newHSMSign creates a signing implementation using a private key stored in an HSM. The HSM session and signer factory
+// are released by the closer.
+func newHSMSign(config *HSM, closer *closer) (identity.Sign, error) {
+	factory, err := identity.NewHSMSignerFactory(config.Library)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HSM signer factory: %w", err)
+	}
+	closer.add(func() error {
+		factory.Dispose()
+		return nil
+	})
+
+	sign, closeSign, err := factory.NewHSMSigner(identity.HSMSignerOptions{
+		Label:      config.Label,
+		Pin:        config.Pin,
+		Identifier: config.Identifier,
+		UserType:   config.UserType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HSM signer: %w", err)
+	}
+	closer.add(closeSign)
+
+	return sign, nil
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metadata provides a model of the metadata that describes smart contracts implemented using a Fabric contract
+// API. Chaincode exposes this metadata using the org.hyperledger.fabric:GetMetadata transaction function. The metadata
+// describes the contracts within the chaincode, their transaction functions, and JSON schemas for transaction
+// parameters and return values.
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// SystemContractName is the name of the system contract included in chaincode implemented using a Fabric contract
+	// API.
+	SystemContractName = "org.hyperledger.fabric"
+	// GetMetadataTransactionName is the name of the system contract transaction function that returns the chaincode
+	// metadata.
+	GetMetadataTransactionName = "GetMetadata"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// Metadata describes the smart contracts within a chaincode.
+type Metadata struct {
+	// Info describes the chaincode.
+	Info *Info `json:"info,omitempty"`
+	// Contracts within the chaincode, keyed by contract name.
+	Contracts map[string]*Contract `json:"contracts"`
+	// Components are reusable definitions referenced by contracts.
+	Components Components `json:"components"`
+}
+
+// Info provides descriptive information about chaincode or a contract.
+type Info struct {
+	Title       string `json:"title,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Contract describes a smart contract within a chaincode.
+type Contract struct {
+	// Name of the contract.
+	Name string `json:"name"`
+	// Info describes the contract.
+	Info *Info `json:"info,omitempty"`
+	// Transactions are the transaction functions provided by the contract.
+	Transactions []*Transaction `json:"transactions"`
+	// Default is true if this is the default contract for the chaincode, which is invoked by transaction names that
+	// are not qualified with a contract name.
+	Default bool `json:"default,omitempty"`
+}
+
+// Transaction describes a transaction function.
+type Transaction struct {
+	// Name of the transaction function.
+	Name string `json:"name"`
+	// Tags associated with the transaction function, which indicate whether it should be submitted or evaluated.
+	Tags []string `json:"tag,omitempty"`
+	// Parameters of the transaction function, in the order they are passed as arguments.
+	Parameters []*Parameter `json:"parameters,omitempty"`
+	// Returns is the schema of the transaction function result, or nil if the transaction function does not return a
+	// value.
+	Returns *Schema `json:"returns,omitempty"`
+}
+
+// Parameter describes a transaction function parameter.
+type Parameter struct {
+	// Name of the parameter.
+	Name string `json:"name"`
+	// Description of the parameter.
+	Description string `json:"description,omitempty"`
+	// Schema of the parameter value.
+	Schema *Schema `json:"schema"`
+}
+
+// Components are reusable definitions referenced by contracts.
+type Components struct {
+	// Schemas are the JSON schemas of complex types, keyed by schema name.
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+// Schema is a JSON schema describing a value.
+type Schema struct {
+	ID          string `json:"$id,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Items is the schema of array elements.
+	Items *Schema `json:"items,omitempty"`
+	// Properties are the schemas of object properties, keyed by property name.
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	// Required are the names of object properties that must be present.
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties describes object properties not listed in Properties. If nil, any additional properties
+	// are allowed.
+	AdditionalProperties *AdditionalProperties `json:"additionalProperties,omitempty"`
+	// Enum lists the allowed values.
+	Enum []any `json:"enum,omitempty"`
+	// Pattern is a regular expression that string values must match.
+	Pattern   string   `json:"pattern,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+	MinItems  *int     `json:"minItems,omitempty"`
+	MaxItems  *int     `json:"maxItems,omitempty"`
+}
+
+// AdditionalProperties describes object properties not listed in a schema's properties. In JSON it is either a
+// boolean, indicating whether additional properties are allowed, or a schema that additional property values must
+// match.
+type AdditionalProperties struct {
+	// Allowed is true if additional properties are allowed.
+	Allowed bool
+	// Schema that additional property values must match, or nil if they may have any value.
+	Schema *Schema
+}
+
+// UnmarshalJSON accepts either a boolean or a schema.
+func (additional *AdditionalProperties) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &additional.Allowed); err == nil {
+		additional.Schema = nil
+		return nil
+	}
+
+	additional.Allowed = true
+	additional.Schema = &Schema{}
+	return json.Unmarshal(data, additional.Schema)
+}
+
+// MarshalJSON produces either a boolean or a schema.
+func (additional AdditionalProperties) MarshalJSON() ([]byte, error) {
+	if additional.Schema != nil {
+		return json.Marshal(additional.Schema)
+	}
+	return json.Marshal(additional.Allowed)
+}
+
+// Parse JSON metadata, such as the result of the GetMetadata system contract transaction function.
+func Parse(data []byte) (*Metadata, error) {
+	result := &Metadata{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	for name, contract := range result.Contracts {
+		if contract.Name == "" {
+			contract.Name = name
+		}
+	}
+
+	return result, nil
+}
+
+// ApplicationContracts returns the contracts within the chaincode, excluding the system contract, sorted by name.
+func (metadata *Metadata) ApplicationContracts() []*Contract {
+	results := make([]*Contract, 0, len(metadata.Contracts))
+	for _, contract := range metadata.Contracts {
+		if contract.Name != SystemContractName {
+			results = append(results, contract)
+		}
+	}
+
+	slices.SortFunc(results, func(a, b *Contract) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return results
+}
+
+// Resolve returns the schema referenced by a schema's $ref, or the schema itself if it is not a reference.
+func (metadata *Metadata) Resolve(schema *Schema) (*Schema, error) {
+	if schema == nil || schema.Ref == "" {
+		return schema, nil
+	}
+
+	name, ok := schema.RefName()
+	if !ok {
+		return nil, fmt.Errorf("unsupported schema reference: %s", schema.Ref)
+	}
+
+	result, ok := metadata.Components.Schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("schema not found for reference: %s", schema.Ref)
+	}
+
+	return result, nil
+}
+
+// RefName returns the name of the component schema referenced by the schema's $ref, and true if the schema is a
+// reference to a component schema.
+func (schema *Schema) RefName() (string, bool) {
+	return strings.CutPrefix(schema.Ref, schemaRefPrefix)
+}
+
+// IsSubmit returns true if the transaction function should be submitted to update the ledger, or false if it should
+// only be evaluated. Transaction functions without an evaluate tag are assumed to require submit.
+func (transaction *Transaction) IsSubmit() bool {
+	for _, tag := range transaction.Tags {
+		switch strings.ToLower(tag) {
+		case "evaluate", "evaluatetx":
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnmarshalJSON accepts the different representations of transaction return values produced by Fabric contract API
+// implementations. The return value may be a schema, an object containing a schema property, or an array containing a
+// single such object.
+func (transaction *Transaction) UnmarshalJSON(data []byte) error {
+	type transactionJSON Transaction
+	var result struct {
+		transactionJSON
+		Returns json.RawMessage `json:"returns,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+
+	returns, err := parseReturns(result.Returns)
+	if err != nil {
+		return fmt.Errorf("invalid returns for transaction %s: %w", result.Name, err)
+	}
+
+	*transaction = Transaction(result.transactionJSON)
+	transaction.Returns = returns
+
+	return nil
+}
+
+func parseReturns(data json.RawMessage) (*Schema, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var returns []json.RawMessage
+		if err := json.Unmarshal(data, &returns); err != nil {
+			return nil, err
+		}
+		if len(returns) == 0 {
+			return nil, nil
+		}
+		return parseReturns(returns[0])
+	}
+
+	var wrapper struct {
+		Schema *Schema `json:"schema"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, err
+	}
+	if wrapper.Schema != nil {
+		return wrapper.Schema, nil
+	}
+
+	result := &Schema{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Parses contracts and schemas", func(t *testing.T) {
+		data := []byte(`{
+			"info": {"title": "basic", "version": "1.0.0"},
+			"contracts": {
+				"AssetContract": {
+					"name": "AssetContract",
+					"transactions": [
+						{
+							"name": "ReadAsset",
+							"tag": ["evaluate"],
+							"parameters": [{"name": "id", "description": "Asset ID", "schema": {"type": "string"}}],
+							"returns": {"$ref": "#/components/schemas/Asset"}
+						}
+					],
+					"default": true
+				}
+			},
+			"components": {
+				"schemas": {
+					"Asset": {"$id": "Asset", "type": "object", "properties": {"ID": {"type": "string"}}, "required": ["ID"]}
+				}
+			}
+		}`)
+
+		actual, err := Parse(data)
+		require.NoError(t, err)
+
+		expected := &Metadata{
+			Info: &Info{Title: "basic", Version: "1.0.0"},
+			Contracts: map[string]*Contract{
+				"AssetContract": {
+					Name: "AssetContract",
+					Transactions: []*Transaction{
+						{
+							Name: "ReadAsset",
+							Tags: []string{"evaluate"},
+							Parameters: []*Parameter{
+								{Name: "id", Description: "Asset ID", Schema: &Schema{Type: "string"}},
+							},
+							Returns: &Schema{Ref: "#/components/schemas/Asset"},
+						},
+					},
+					Default: true,
+				},
+			},
+			Components: Components{
+				Schemas: map[string]*Schema{
+					"Asset": {
+						ID:         "Asset",
+						Type:       "object",
+						Properties: map[string]*Schema{"ID": {Type: "string"}},
+						Required:   []string{"ID"},
+					},
+				},
+			},
+		}
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("Uses contract key as missing contract name", func(t *testing.T) {
+		actual, err := Parse([]byte(`{"contracts": {"AssetContract": {"transactions": []}}}`))
+		require.NoError(t, err)
+
+		require.Equal(t, "AssetContract", actual.Contracts["AssetContract"].Name)
+	})
+
+	for name, returns := range map[string]string{
+		"schema":                   `{"type": "string"}`,
+		"object containing schema": `{"schema": {"type": "string"}}`,
+		"array":                    `[{"name": "success", "schema": {"type": "string"}}]`,
+	} {
+		t.Run("Parses returns as "+name, func(t *testing.T) {
+			actual, err := Parse([]byte(`{"contracts": {"C": {"transactions": [{"name": "T", "returns": ` + returns + `}]}}}`))
+			require.NoError(t, err)
+
+			require.Equal(t, &Schema{Type: "string"}, actual.Contracts["C"].Transactions[0].Returns)
+		})
+	}
+
+	t.Run("Transaction without returns has nil result schema", func(t *testing.T) {
+		actual, err := Parse([]byte(`{"contracts": {"C": {"transactions": [{"name": "T"}]}}}`))
+		require.NoError(t, err)
+
+		require.Nil(t, actual.Contracts["C"].Transactions[0].Returns)
+	})
+
+	t.Run("Fails for invalid JSON", func(t *testing.T) {
+		_, err := Parse([]byte("{"))
+		require.Error(t, err)
+	})
+}
+
+func TestMetadata(t *testing.T) {
+	t.Run("ApplicationContracts excludes system contract and sorts by name", func(t *testing.T) {
+		metadata := &Metadata{
+			Contracts: map[string]*Contract{
+				"B":                {Name: "B"},
+				SystemContractName: {Name: SystemContractName},
+				"A":                {Name: "A"},
+			},
+		}
+
+		actual := metadata.ApplicationContracts()
+
+		require.Equal(t, []*Contract{{Name: "A"}, {Name: "B"}}, actual)
+	})
+
+	t.Run("Resolve returns referenced component schema", func(t *testing.T) {
+		expected := &Schema{Type: "object"}
+		metadata := &Metadata{
+			Components: Components{
+				Schemas: map[string]*Schema{"Asset": expected},
+			},
+		}
+
+		actual, err := metadata.Resolve(&Schema{Ref: "#/components/schemas/Asset"})
+		require.NoError(t, err)
+
+		require.Same(t, expected, actual)
+	})
+
+	t.Run("Resolve returns schema that is not a reference", func(t *testing.T) {
+		expected := &Schema{Type: "string"}
+
+		actual, err := (&Metadata{}).Resolve(expected)
+		require.NoError(t, err)
+
+		require.Same(t, expected, actual)
+	})
+
+	t.Run("Resolve fails for missing component schema", func(t *testing.T) {
+		_, err := (&Metadata{}).Resolve(&Schema{Ref: "#/components/schemas/Missing"})
+		require.Error(t, err)
+	})
+
+	for tags, expected := range map[string]bool{
+		"":           true,
+		"submit":     true,
+		"SUBMIT":     true,
+		"submitTx":   true,
+		"evaluate":   false,
+		"EVALUATE":   false,
+		"evaluateTx": false,
+	} {
+		t.Run("IsSubmit with tag "+tags, func(t *testing.T) {
+			transaction := &Transaction{}
+			if tags != "" {
+				transaction.Tags = []string{tags}
+			}
+
+			require.Equal(t, expected, transaction.IsSubmit())
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Contract returns the contract with the specified name, or the default contract if the name is empty.
+func (metadata *Metadata) Contract(name string) (*Contract, error) {
+	if name != "" {
+		contract, ok := metadata.Contracts[name]
+		if !ok {
+			return nil, fmt.Errorf("contract not found: %s", name)
+		}
+		return contract, nil
+	}
+
+	for _, contract := range metadata.Contracts {
+		if contract.Default {
+			return contract, nil
+		}
+	}
+
+	return nil, errors.New("no default contract")
+}
+
+// Transaction returns the transaction function with the specified name. The name may be qualified with a contract
+// name, in the form contract:transaction; otherwise the transaction function is in the default contract.
+func (metadata *Metadata) Transaction(name string) (*Transaction, error) {
+	contractName := ""
+	transactionName := name
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		contractName = name[:i]
+		transactionName = name[i+1:]
+	}
+
+	contract, err := metadata.Contract(contractName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transaction := range contract.Transactions {
+		if transaction.Name == transactionName {
+			return transaction, nil
+		}
+	}
+
+	return nil, fmt.Errorf("transaction function %s not found in contract %s", transactionName, contract.Name)
+}
+
+// ValidateArguments checks that transaction function arguments match the parameters of the named transaction
+// function. The name may be qualified with a contract name, in the form contract:transaction. Arguments for string
+// parameters are the string values; arguments for other parameters are JSON values.
+func (metadata *Metadata) ValidateArguments(transactionName string, args [][]byte) error {
+	transaction, err := metadata.Transaction(transactionName)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != len(transaction.Parameters) {
+		return fmt.Errorf("transaction function %s expects %d arguments, got %d",
+			transactionName, len(transaction.Parameters), len(args))
+	}
+
+	var errs []error
+	for i, parameter := range transaction.Parameters {
+		if err := metadata.validateArgument(parameter.Schema, args[i]); err != nil {
+			errs = append(errs, fmt.Errorf("argument %d (%s): %w", i, parameter.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (metadata *Metadata) validateArgument(schema *Schema, arg []byte) error {
+	resolved, err := metadata.Resolve(schema)
+	if err != nil {
+		return err
+	}
+
+	if resolved != nil && resolved.Type == "string" {
+		return metadata.validate("$", resolved, string(arg))
+	}
+
+	value, err := decodeJSON(arg)
+	if err != nil {
+		return err
+	}
+
+	return metadata.validate("$", resolved, value)
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid JSON: unexpected data after value")
+	}
+
+	return result, nil
+}
+
+// validate checks that a decoded JSON value matches a schema. Numbers are represented as json.Number values.
+func (metadata *Metadata) validate(path string, schema *Schema, value any) error {
+	schema, err := metadata.Resolve(schema)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+
+	if err := validateEnum(path, schema, value); err != nil {
+		return err
+	}
+
+	return metadata.validateType(path, schema, value)
+}
+
+func (metadata *Metadata) validateType(path string, schema *Schema, value any) error {
+	switch schema.Type {
+	case "string":
+		return validateString(path, schema, value)
+	case "integer":
+		return validateInteger(path, schema, value)
+	case "number":
+		return validateNumber(path, schema, value)
+	case "boolean":
+		return validateBoolean(path, schema, value)
+	case "array":
+		return metadata.validateArray(path, schema, value)
+	case "object":
+		return metadata.validateObject(path, schema, value)
+	default:
+		return nil
+	}
+}
+
+func validateEnum(path string, schema *Schema, value any) error {
+	if len(schema.Enum) == 0 {
+		return nil
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	for _, allowed := range schema.Enum {
+		if allowedJSON, err := json.Marshal(allowed); err == nil && bytes.Equal(valueJSON, allowedJSON) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: value %s is not one of the allowed values", path, valueJSON)
+}
+
+func validateString(path string, schema *Schema, value any) error {
+	text, ok := value.(string)
+	if !ok {
+		return typeError(path, schema, value)
+	}
+
+	length := utf8.RuneCountInString(text)
+	if schema.MinLength != nil && length < *schema.MinLength {
+		return fmt.Errorf("%s: length %d is less than minimum %d", path, length, *schema.MinLength)
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		return fmt.Errorf("%s: length %d is greater than maximum %d", path, length, *schema.MaxLength)
+	}
+
+	if schema.Pattern != "" {
+		pattern, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern in schema: %w", path, err)
+		}
+		if !pattern.MatchString(text) {
+			return fmt.Errorf("%s: value does not match pattern %s", path, schema.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func validateInteger(path string, schema *Schema, value any) error {
+	number, ok := value.(json.Number)
+	if !ok {
+		return typeError(path, schema, value)
+	}
+
+	if err := checkIntegerFormat(number.String(), schema.Format); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return validateRange(path, schema, number)
+}
+
+// checkIntegerFormat checks that an integer value is within the range of its format, such as int32 or uint64.
+func checkIntegerFormat(value string, format string) error {
+	var err error
+	if bitSize, ok := strings.CutPrefix(format, "uint"); ok {
+		_, err = strconv.ParseUint(value, 10, intBitSize(bitSize))
+	} else {
+		_, err = strconv.ParseInt(value, 10, intBitSize(strings.TrimPrefix(format, "int")))
+	}
+
+	if err != nil {
+		return fmt.Errorf("value %s is not a valid integer for format %s", value, format)
+	}
+
+	return nil
+}
+
+func intBitSize(text string) int {
+	bitSize, err := strconv.Atoi(text)
+	if err != nil {
+		return 64
+	}
+	return bitSize
+}
+
+func validateNumber(path string, schema *Schema, value any) error {
+	number, ok := value.(json.Number)
+	if !ok {
+		return typeError(path, schema, value)
+	}
+
+	return validateRange(path, schema, number)
+}
+
+func validateRange(path string, schema *Schema, number json.Number) error {
+	value, err := number.Float64()
+	if err != nil {
+		return fmt.Errorf("%s: invalid number %s", path, number)
+	}
+
+	if schema.Minimum != nil && value < *schema.Minimum {
+		return fmt.Errorf("%s: value %s is less than minimum %v", path, number, *schema.Minimum)
+	}
+	if schema.Maximum != nil && value > *schema.Maximum {
+		return fmt.Errorf("%s: value %s is greater than maximum %v", path, number, *schema.Maximum)
+	}
+
+	return nil
+}
+
+func validateBoolean(path string, schema *Schema, value any) error {
+	if _, ok := value.(bool); !ok {
+		return typeError(path, schema, value)
+	}
+	return nil
+}
+
+func (metadata *Metadata) validateArray(path string, schema *Schema, value any) error {
+	items, ok := value.([]any)
+	if !ok {
+		return typeError(path, schema, value)
+	}
+
+	if schema.MinItems != nil && len(items) < *schema.MinItems {
+		return fmt.Errorf("%s: %d items is less than minimum %d", path, len(items), *schema.MinItems)
+	}
+	if schema.MaxItems != nil && len(items) > *schema.MaxItems {
+		return fmt.Errorf("%s: %d items is greater than maximum %d", path, len(items), *schema.MaxItems)
+	}
+
+	var errs []error
+	for i, item := range items {
+		if err := metadata.validate(fmt.Sprintf("%s[%d]", path, i), schema.Items, item); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (metadata *Metadata) validateObject(path string, schema *Schema, value any) error {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return typeError(path, schema, value)
+	}
+
+	var errs []error
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			errs = append(errs, fmt.Errorf("%s: missing required property %s", path, name))
+		}
+	}
+
+	for name, propertyValue := range object {
+		if err := metadata.validateProperty(path+"."+name, schema, name, propertyValue); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (metadata *Metadata) validateProperty(path string, schema *Schema, name string, value any) error {
+	if propertySchema, ok := schema.Properties[name]; ok {
+		return metadata.validate(path, propertySchema, value)
+	}
+
+	additional := schema.AdditionalProperties
+	if additional == nil {
+		return nil
+	}
+	if !additional.Allowed {
+		return fmt.Errorf("%s: unexpected property", path)
+	}
+
+	return metadata.validate(path, additional.Schema, value)
+}
+
+func typeError(path string, schema *Schema, value any) error {
+	return fmt.Errorf("%s: expected %s, got %s", path, schema.Type, jsonTypeName(value))
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
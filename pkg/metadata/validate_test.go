@@ -0,0 +1,230 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMetadata(t *testing.T) *Metadata {
+	result, err := Parse([]byte(`{
+		"contracts": {
+			"AssetContract": {
+				"name": "AssetContract",
+				"default": true,
+				"transactions": [
+					{
+						"name": "CreateAsset",
+						"parameters": [
+							{"name": "id", "schema": {"type": "string", "pattern": "^asset[0-9]+$"}},
+							{"name": "size", "schema": {"type": "integer", "format": "int32", "minimum": 1}},
+							{"name": "asset", "schema": {"$ref": "#/components/schemas/Asset"}}
+						]
+					},
+					{
+						"name": "SetTags",
+						"parameters": [
+							{"name": "tags", "schema": {"type": "array", "items": {"type": "string", "enum": ["red", "blue"]}, "maxItems": 2}},
+							{"name": "attributes", "schema": {"type": "object", "additionalProperties": {"type": "boolean"}}}
+						]
+					}
+				]
+			},
+			"OtherContract": {
+				"name": "OtherContract",
+				"transactions": [
+					{"name": "Ping", "parameters": [{"name": "ratio", "schema": {"type": "number", "maximum": 1}}]}
+				]
+			}
+		},
+		"components": {
+			"schemas": {
+				"Asset": {
+					"$id": "Asset",
+					"type": "object",
+					"properties": {
+						"Color": {"type": "string", "minLength": 1},
+						"Owner": {"type": "string"}
+					},
+					"required": ["Color"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	return result
+}
+
+func toArgs(args ...string) [][]byte {
+	results := make([][]byte, 0, len(args))
+	for _, arg := range args {
+		results = append(results, []byte(arg))
+	}
+	return results
+}
+
+func TestValidateArguments(t *testing.T) {
+	t.Run("Valid arguments", func(t *testing.T) {
+		for name, testCase := range map[string]struct {
+			transactionName string
+			args            [][]byte
+		}{
+			"default contract": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "10", `{"Color": "red"}`),
+			},
+			"qualified contract name": {
+				transactionName: "AssetContract:CreateAsset",
+				args:            toArgs("asset1", "10", `{"Color": "red", "Owner": "Tomoko"}`),
+			},
+			"non-default contract": {
+				transactionName: "OtherContract:Ping",
+				args:            toArgs("0.5"),
+			},
+			"arrays and maps": {
+				transactionName: "SetTags",
+				args:            toArgs(`["red", "blue"]`, `{"shiny": true}`),
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := newTestMetadata(t).ValidateArguments(testCase.transactionName, testCase.args)
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("Invalid arguments", func(t *testing.T) {
+		for name, testCase := range map[string]struct {
+			transactionName string
+			args            [][]byte
+			expected        string
+		}{
+			"unknown transaction": {
+				transactionName: "DeleteAsset",
+				args:            toArgs(),
+				expected:        "DeleteAsset",
+			},
+			"unknown contract": {
+				transactionName: "MissingContract:Ping",
+				args:            toArgs(),
+				expected:        "MissingContract",
+			},
+			"wrong argument count": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1"),
+				expected:        "expects 3 arguments, got 1",
+			},
+			"string pattern": {
+				transactionName: "CreateAsset",
+				args:            toArgs("car1", "10", `{"Color": "red"}`),
+				expected:        "argument 0 (id)",
+			},
+			"integer type": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", `"ten"`, `{"Color": "red"}`),
+				expected:        "expected integer, got string",
+			},
+			"integer fraction": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "1.5", `{"Color": "red"}`),
+				expected:        "argument 1 (size)",
+			},
+			"integer format range": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "3000000000", `{"Color": "red"}`),
+				expected:        "format int32",
+			},
+			"integer minimum": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "0", `{"Color": "red"}`),
+				expected:        "less than minimum",
+			},
+			"malformed JSON": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "10", `{"Color": `),
+				expected:        "invalid JSON",
+			},
+			"missing required property": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "10", `{"Owner": "Tomoko"}`),
+				expected:        "missing required property Color",
+			},
+			"unexpected property": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "10", `{"Color": "red", "Size": 1}`),
+				expected:        "$.Size: unexpected property",
+			},
+			"nested string length": {
+				transactionName: "CreateAsset",
+				args:            toArgs("asset1", "10", `{"Color": ""}`),
+				expected:        "$.Color: length 0",
+			},
+			"array enum": {
+				transactionName: "SetTags",
+				args:            toArgs(`["green"]`, `{}`),
+				expected:        "$[0]: value \"green\" is not one of the allowed values",
+			},
+			"array maximum items": {
+				transactionName: "SetTags",
+				args:            toArgs(`["red", "blue", "red"]`, `{}`),
+				expected:        "greater than maximum 2",
+			},
+			"additional property schema": {
+				transactionName: "SetTags",
+				args:            toArgs(`[]`, `{"shiny": "yes"}`),
+				expected:        "$.shiny: expected boolean, got string",
+			},
+			"number maximum": {
+				transactionName: "OtherContract:Ping",
+				args:            toArgs("1.5"),
+				expected:        "greater than maximum",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := newTestMetadata(t).ValidateArguments(testCase.transactionName, testCase.args)
+				require.ErrorContains(t, err, testCase.expected)
+			})
+		}
+	})
+
+	t.Run("Reports all invalid arguments", func(t *testing.T) {
+		err := newTestMetadata(t).ValidateArguments("CreateAsset", toArgs("car1", "0", `{"Color": "red"}`))
+
+		require.ErrorContains(t, err, "argument 0 (id)")
+		require.ErrorContains(t, err, "argument 1 (size)")
+	})
+
+	t.Run("Fails without default contract for unqualified transaction name", func(t *testing.T) {
+		metadata := &Metadata{
+			Contracts: map[string]*Contract{
+				"AssetContract": {Name: "AssetContract"},
+			},
+		}
+
+		err := metadata.ValidateArguments("CreateAsset", nil)
+		require.ErrorContains(t, err, "no default contract")
+	})
+}
+
+func TestAdditionalProperties(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		json     string
+		expected *AdditionalProperties
+	}{
+		"false":  {json: `{"additionalProperties": false}`, expected: &AdditionalProperties{}},
+		"true":   {json: `{"additionalProperties": true}`, expected: &AdditionalProperties{Allowed: true}},
+		"schema": {json: `{"additionalProperties": {"type": "string"}}`, expected: &AdditionalProperties{Allowed: true, Schema: &Schema{Type: "string"}}},
+	} {
+		t.Run("Parses "+name, func(t *testing.T) {
+			actual, err := Parse([]byte(`{"components": {"schemas": {"S": ` + testCase.json + `}}}`))
+			require.NoError(t, err)
+
+			require.Equal(t, testCase.expected, actual.Components.Schemas["S"].AdditionalProperties)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"strings"
+	"sync"
+)
+
+// InMemoryProvider is a [Provider] implementation that stores metric values in memory. Recorded values can be
+// inspected using the fully qualified metric name and label values. It is safe for concurrent use.
+//
+// Instances should be created using the [NewInMemoryProvider] constructor function.
+type InMemoryProvider struct {
+	lock         sync.Mutex
+	values       map[string]float64
+	observations map[string][]float64
+}
+
+// NewInMemoryProvider creates a properly initialized InMemoryProvider.
+func NewInMemoryProvider() *InMemoryProvider {
+	return &InMemoryProvider{
+		values:       make(map[string]float64),
+		observations: make(map[string][]float64),
+	}
+}
+
+// NewCounter creates a counter.
+func (provider *InMemoryProvider) NewCounter(opts CounterOpts) Counter {
+	return inMemoryCounter{provider.newInstrument(opts.Namespace, opts.Subsystem, opts.Name)}
+}
+
+// NewGauge creates a gauge.
+func (provider *InMemoryProvider) NewGauge(opts GaugeOpts) Gauge {
+	return inMemoryGauge{provider.newInstrument(opts.Namespace, opts.Subsystem, opts.Name)}
+}
+
+// NewHistogram creates a histogram.
+func (provider *InMemoryProvider) NewHistogram(opts HistogramOpts) Histogram {
+	return inMemoryHistogram{provider.newInstrument(opts.Namespace, opts.Subsystem, opts.Name)}
+}
+
+func (provider *InMemoryProvider) newInstrument(namespace string, subsystem string, name string) *inMemoryInstrument {
+	return &inMemoryInstrument{
+		provider: provider,
+		name:     FullyQualifiedName(namespace, subsystem, name),
+	}
+}
+
+// Value of the counter or gauge with the specified fully qualified name and label values. Zero is returned if no value
+// has been recorded.
+func (provider *InMemoryProvider) Value(name string, labelValues ...string) float64 {
+	provider.lock.Lock()
+	defer provider.lock.Unlock()
+
+	return provider.values[metricKey(name, labelValues)]
+}
+
+// Observations recorded by the histogram with the specified fully qualified name and label values, in the order they
+// were recorded.
+func (provider *InMemoryProvider) Observations(name string, labelValues ...string) []float64 {
+	provider.lock.Lock()
+	defer provider.lock.Unlock()
+
+	return append([]float64(nil), provider.observations[metricKey(name, labelValues)]...)
+}
+
+func metricKey(name string, labelValues []string) string {
+	return strings.Join(append([]string{name}, labelValues...), "\x00")
+}
+
+type inMemoryInstrument struct {
+	provider    *InMemoryProvider
+	name        string
+	labelValues []string
+}
+
+func (instrument *inMemoryInstrument) with(labelValues []string) *inMemoryInstrument {
+	return &inMemoryInstrument{
+		provider:    instrument.provider,
+		name:        instrument.name,
+		labelValues: append(append([]string(nil), instrument.labelValues...), labelValues...),
+	}
+}
+
+func (instrument *inMemoryInstrument) key() string {
+	return metricKey(instrument.name, instrument.labelValues)
+}
+
+func (instrument *inMemoryInstrument) Add(delta float64) {
+	instrument.provider.lock.Lock()
+	defer instrument.provider.lock.Unlock()
+
+	instrument.provider.values[instrument.key()] += delta
+}
+
+func (instrument *inMemoryInstrument) Set(value float64) {
+	instrument.provider.lock.Lock()
+	defer instrument.provider.lock.Unlock()
+
+	instrument.provider.values[instrument.key()] = value
+}
+
+func (instrument *inMemoryInstrument) Observe(value float64) {
+	instrument.provider.lock.Lock()
+	defer instrument.provider.lock.Unlock()
+
+	key := instrument.key()
+	instrument.provider.observations[key] = append(instrument.provider.observations[key], value)
+}
+
+type inMemoryCounter struct {
+	*inMemoryInstrument
+}
+
+func (counter inMemoryCounter) With(labelValues ...string) Counter {
+	return inMemoryCounter{counter.with(labelValues)}
+}
+
+type inMemoryGauge struct {
+	*inMemoryInstrument
+}
+
+func (gauge inMemoryGauge) With(labelValues ...string) Gauge {
+	return inMemoryGauge{gauge.with(labelValues)}
+}
+
+type inMemoryHistogram struct {
+	*inMemoryInstrument
+}
+
+func (histogram inMemoryHistogram) With(labelValues ...string) Histogram {
+	return inMemoryHistogram{histogram.with(labelValues)}
+}
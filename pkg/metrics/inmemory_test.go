@@ -0,0 +1,51 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryProvider(t *testing.T) {
+	t.Run("Counter values recorded separately for each label value", func(t *testing.T) {
+		provider := NewInMemoryProvider()
+		counter := provider.NewCounter(CounterOpts{Namespace: "NAMESPACE", Name: "COUNTER", LabelNames: []string{"LABEL"}})
+
+		counter.With("A").Add(1)
+		counter.With("A").Add(2)
+		counter.With("B").Add(5)
+
+		require.InDelta(t, 3, provider.Value("NAMESPACE_COUNTER", "A"), 0)
+		require.InDelta(t, 5, provider.Value("NAMESPACE_COUNTER", "B"), 0)
+	})
+
+	t.Run("Gauge set replaces previous value", func(t *testing.T) {
+		provider := NewInMemoryProvider()
+		gauge := provider.NewGauge(GaugeOpts{Name: "GAUGE"})
+
+		gauge.Add(3)
+		gauge.Set(1)
+
+		require.InDelta(t, 1, provider.Value("GAUGE"), 0)
+	})
+
+	t.Run("Histogram observations recorded in order", func(t *testing.T) {
+		provider := NewInMemoryProvider()
+		histogram := provider.NewHistogram(HistogramOpts{Subsystem: "SUBSYSTEM", Name: "HISTOGRAM", LabelNames: []string{"L1", "L2"}})
+
+		histogram.With("A").With("B").Observe(2)
+		histogram.With("A", "B").Observe(1)
+
+		require.Equal(t, []float64{2, 1}, provider.Observations("SUBSYSTEM_HISTOGRAM", "A", "B"))
+	})
+
+	t.Run("Unrecorded values are zero", func(t *testing.T) {
+		provider := NewInMemoryProvider()
+
+		require.Zero(t, provider.Value("MISSING"))
+		require.Empty(t, provider.Observations("MISSING"))
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics provides an abstraction used by the Fabric Gateway client API to record metrics. Implementations can
+// adapt the abstraction to a metrics system such as Prometheus. [InMemoryProvider] stores metrics in memory, and can
+// be used to inspect recorded values without any metrics system, for example in tests.
+package metrics
+
+// Provider creates metric instruments.
+type Provider interface {
+	// NewCounter creates a counter.
+	NewCounter(opts CounterOpts) Counter
+	// NewGauge creates a gauge.
+	NewGauge(opts GaugeOpts) Gauge
+	// NewHistogram creates a histogram.
+	NewHistogram(opts HistogramOpts) Histogram
+}
+
+// Counter is a metric that can only increase.
+type Counter interface {
+	// With returns a counter for the supplied label values, which correspond to the label names in the counter
+	// options.
+	With(labelValues ...string) Counter
+	// Add increments the counter by a non-negative delta.
+	Add(delta float64)
+}
+
+// Gauge is a metric that can increase or decrease.
+type Gauge interface {
+	// With returns a gauge for the supplied label values, which correspond to the label names in the gauge options.
+	With(labelValues ...string) Gauge
+	// Add a delta, which may be negative, to the gauge.
+	Add(delta float64)
+	// Set the gauge to a specific value.
+	Set(value float64)
+}
+
+// Histogram is a metric that samples observations into buckets.
+type Histogram interface {
+	// With returns a histogram for the supplied label values, which correspond to the label names in the histogram
+	// options.
+	With(labelValues ...string) Histogram
+	// Observe records an observation.
+	Observe(value float64)
+}
+
+// CounterOpts describe a counter.
+type CounterOpts struct {
+	Namespace  string
+	Subsystem  string
+	Name       string
+	Help       string
+	LabelNames []string
+}
+
+// GaugeOpts describe a gauge.
+type GaugeOpts struct {
+	Namespace  string
+	Subsystem  string
+	Name       string
+	Help       string
+	LabelNames []string
+}
+
+// HistogramOpts describe a histogram.
+type HistogramOpts struct {
+	Namespace  string
+	Subsystem  string
+	Name       string
+	Help       string
+	LabelNames []string
+	// Buckets are the upper bounds of the histogram buckets, in increasing order.
+	Buckets []float64
+}
+
+// FullyQualifiedName joins the non-empty namespace, subsystem and name with underscores, as is conventional for
+// Prometheus metric names.
+func FullyQualifiedName(namespace string, subsystem string, name string) string {
+	result := name
+	if subsystem != "" {
+		result = subsystem + "_" + result
+	}
+	if namespace != "" {
+		result = namespace + "_" + result
+	}
+	return result
+}
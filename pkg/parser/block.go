@@ -0,0 +1,88 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package parser provides parsing of the ledger content delivered by Fabric block events into typed structures,
+// avoiding the need for client applications to unmarshal nested protobuf messages.
+package parser
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Block is the parsed content of a block.
+type Block struct {
+	// Number of the block.
+	Number uint64
+	// Transactions contained in the block, in the order they appear in the block.
+	Transactions []*Transaction
+	// ParseErrors are errors encountered parsing block content that does not belong to any transaction in the block,
+	// such as private data for a transaction number not present in the block.
+	ParseErrors []error
+
+	block *common.Block
+}
+
+// Proto returns the protobuf message from which the block was parsed.
+func (b *Block) Proto() *common.Block {
+	return b.block
+}
+
+// ParseBlock parses the content of a block, such as a block received from block events. Committed blocks can contain
+// malformed transactions, so a transaction that cannot be parsed does not cause the block to fail to parse. Instead,
+// the parse error is recorded in the ParseError field of that transaction.
+func ParseBlock(block *common.Block) (*Block, error) {
+	validationCodes := block.GetMetadata().GetMetadata()
+	var transactionsFilter []byte
+	if len(validationCodes) > int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		transactionsFilter = validationCodes[common.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	}
+
+	result := &Block{
+		Number:       block.GetHeader().GetNumber(),
+		Transactions: make([]*Transaction, 0, len(block.GetData().GetData())),
+		block:        block,
+	}
+
+	for i, envelopeBytes := range block.GetData().GetData() {
+		transaction := parseBlockTransaction(envelopeBytes)
+		if transaction.ParseError != nil {
+			transaction.ParseError = fmt.Errorf("failed to parse transaction %d in block %d: %w", i, result.Number, transaction.ParseError)
+		}
+
+		transaction.ValidationCode = validationCode(transactionsFilter, i)
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	return result, nil
+}
+
+func parseBlockTransaction(envelopeBytes []byte) *Transaction {
+	envelope := &common.Envelope{}
+	if err := proto.Unmarshal(envelopeBytes, envelope); err != nil {
+		return &Transaction{
+			ParseError: fmt.Errorf("failed to deserialize envelope: %w", err),
+		}
+	}
+
+	transaction, err := ParseEnvelope(envelope)
+	if err != nil {
+		return &Transaction{
+			ParseError: err,
+			envelope:   envelope,
+		}
+	}
+
+	return transaction
+}
+
+func validationCode(transactionsFilter []byte, index int) peer.TxValidationCode {
+	if index >= len(transactionsFilter) {
+		return peer.TxValidationCode_NOT_VALIDATED
+	}
+
+	return peer.TxValidationCode(transactionsFilter[index])
+}
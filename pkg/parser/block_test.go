@@ -0,0 +1,111 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlock(t *testing.T) {
+	newEnvelope := func(t *testing.T, transactionID string) *common.Envelope {
+		channelHeader := &common.ChannelHeader{
+			Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+			ChannelId: "CHANNEL",
+			TxId:      transactionID,
+		}
+		creator := &msp.SerializedIdentity{
+			Mspid: "MSP_ID",
+		}
+		return NewTestEnvelope(t, channelHeader, creator, &testAction{
+			chaincodeName: "CHAINCODE",
+		})
+	}
+
+	t.Run("Parses block number", func(t *testing.T) {
+		block := NewTestBlock(t, 101, nil)
+
+		actual, err := ParseBlock(block)
+		require.NoError(t, err)
+
+		require.Equal(t, uint64(101), actual.Number)
+		require.Empty(t, actual.Transactions)
+		require.Same(t, block, actual.Proto())
+	})
+
+	t.Run("Parses transactions with validation codes", func(t *testing.T) {
+		block := NewTestBlock(
+			t,
+			1,
+			[]peer.TxValidationCode{peer.TxValidationCode_VALID, peer.TxValidationCode_MVCC_READ_CONFLICT},
+			newEnvelope(t, "TRANSACTION_1"),
+			newEnvelope(t, "TRANSACTION_2"),
+		)
+
+		actual, err := ParseBlock(block)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Transactions, 2)
+		require.Equal(t, "TRANSACTION_1", actual.Transactions[0].TransactionID())
+		require.Equal(t, peer.TxValidationCode_VALID, actual.Transactions[0].ValidationCode)
+		require.True(t, actual.Transactions[0].IsValid())
+		require.Equal(t, "TRANSACTION_2", actual.Transactions[1].TransactionID())
+		require.Equal(t, peer.TxValidationCode_MVCC_READ_CONFLICT, actual.Transactions[1].ValidationCode)
+		require.False(t, actual.Transactions[1].IsValid())
+	})
+
+	t.Run("Transactions without validation code are not validated", func(t *testing.T) {
+		block := NewTestBlock(t, 1, nil, newEnvelope(t, "TRANSACTION_1"))
+		block.Metadata = nil
+
+		actual, err := ParseBlock(block)
+		require.NoError(t, err)
+
+		require.Equal(t, peer.TxValidationCode_NOT_VALIDATED, actual.Transactions[0].ValidationCode)
+	})
+
+	t.Run("Records parse errors for malformed transactions", func(t *testing.T) {
+		malformedEnvelope := &common.Envelope{Payload: []byte("NOT_A_PAYLOAD")}
+		block := NewTestBlock(
+			t,
+			1,
+			[]peer.TxValidationCode{
+				peer.TxValidationCode_VALID,
+				peer.TxValidationCode_BAD_PAYLOAD,
+				peer.TxValidationCode_BAD_PAYLOAD,
+				peer.TxValidationCode_VALID,
+			},
+			newEnvelope(t, "TRANSACTION_1"),
+			malformedEnvelope,
+			newEnvelope(t, "TRANSACTION_3"),
+			newEnvelope(t, "TRANSACTION_4"),
+		)
+		block.Data.Data[2] = []byte("NOT_AN_ENVELOPE")
+
+		actual, err := ParseBlock(block)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Transactions, 4)
+
+		require.NoError(t, actual.Transactions[0].ParseError)
+		require.Equal(t, "TRANSACTION_1", actual.Transactions[0].TransactionID())
+		require.True(t, actual.Transactions[0].IsValid())
+
+		require.ErrorContains(t, actual.Transactions[1].ParseError, "transaction 1 in block 1")
+		require.Equal(t, peer.TxValidationCode_BAD_PAYLOAD, actual.Transactions[1].ValidationCode)
+		AssertProtoEqual(t, malformedEnvelope, actual.Transactions[1].Proto())
+
+		require.ErrorContains(t, actual.Transactions[2].ParseError, "transaction 2 in block 1")
+		require.Equal(t, peer.TxValidationCode_BAD_PAYLOAD, actual.Transactions[2].ValidationCode)
+		require.Nil(t, actual.Transactions[2].Proto())
+
+		require.NoError(t, actual.Transactions[3].ParseError)
+		require.Equal(t, "TRANSACTION_4", actual.Transactions[3].TransactionID())
+		require.True(t, actual.Transactions[3].IsValid())
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hyperledger/fabric-gateway/pkg/client"
+	"github.com/hyperledger/fabric-gateway/pkg/parser"
+)
+
+func ExampleParseBlock() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocks, err := network.BlockEvents(ctx, client.WithStartBlock(101))
+	panicOnError(err)
+
+	for block := range blocks {
+		parsedBlock, err := parser.ParseBlock(block)
+		panicOnError(err)
+
+		for _, transaction := range parsedBlock.Transactions {
+			if !transaction.IsValid() {
+				continue
+			}
+
+			for _, action := range transaction.Actions {
+				fmt.Printf("Transaction %s invoked chaincode %s by %s\n",
+					transaction.TransactionID(), action.ChaincodeName, transaction.Creator.GetMspid())
+			}
+		}
+	}
+}
+
+func ExampleParseBlockAndPrivateData() {
+	var network *client.Network // Obtained from Gateway.
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := network.BlockAndPrivateDataEvents(ctx, client.WithStartBlock(101))
+	panicOnError(err)
+
+	for event := range events {
+		block, err := parser.ParseBlockAndPrivateData(event)
+		panicOnError(err)
+
+		for _, transaction := range block.Transactions {
+			if !transaction.IsValid() {
+				continue
+			}
+
+			for _, collection := range transaction.PrivateData {
+				for _, write := range collection.ReadWriteSet.GetWrites() {
+					fmt.Printf("Collection %s key %s written by transaction %s\n",
+						collection.CollectionName, write.GetKey(), transaction.TransactionID())
+				}
+			}
+		}
+	}
+}
+
+func panicOnError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
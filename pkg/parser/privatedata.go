@@ -0,0 +1,85 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// CollectionPrivateData describes the private data read and written within a private data collection.
+type CollectionPrivateData struct {
+	// Namespace in which the collection is defined, which is typically a chaincode.
+	Namespace string
+	// CollectionName of the private data collection.
+	CollectionName string
+	// ReadWriteSet containing the private data key reads, and key and value writes, for the collection.
+	ReadWriteSet *kvrwset.KVRWSet
+}
+
+// ParseBlockAndPrivateData parses the content of a block and private data event. Private data is joined with the
+// transactions in the block to which it belongs, and is available from the PrivateData field of each transaction.
+// Only private data that the Gateway peer is authorized to access is included. Private data that cannot be parsed does
+// not cause the block to fail to parse. Instead, the parse error is recorded in the ParseError field of the
+// transaction to which it belongs, or in the ParseErrors field of the block if there is no matching transaction.
+func ParseBlockAndPrivateData(blockAndPrivateData *peer.BlockAndPrivateData) (*Block, error) {
+	block, err := ParseBlock(blockAndPrivateData.GetBlock())
+	if err != nil {
+		return nil, err
+	}
+
+	privateDataMap := blockAndPrivateData.GetPrivateDataMap()
+	for _, txNum := range slices.Sorted(maps.Keys(privateDataMap)) {
+		if txNum >= uint64(len(block.Transactions)) {
+			block.ParseErrors = append(block.ParseErrors,
+				fmt.Errorf("private data for transaction %d not found in block %d", txNum, block.Number))
+			continue
+		}
+
+		transaction := block.Transactions[txNum]
+		privateData, err := ParsePrivateReadWriteSet(privateDataMap[txNum])
+		if err != nil {
+			transaction.ParseError = errors.Join(transaction.ParseError,
+				fmt.Errorf("failed to parse private data for transaction %d in block %d: %w", txNum, block.Number, err))
+			continue
+		}
+
+		transaction.PrivateData = privateData
+	}
+
+	return block, nil
+}
+
+// ParsePrivateReadWriteSet decodes the collection private data contained in a transaction private read-write set.
+func ParsePrivateReadWriteSet(privateReadWriteSet *rwset.TxPvtReadWriteSet) ([]*CollectionPrivateData, error) {
+	if privateReadWriteSet.GetDataModel() != rwset.TxReadWriteSet_KV {
+		return nil, fmt.Errorf("unsupported private read-write set data model: %v", privateReadWriteSet.GetDataModel())
+	}
+
+	var results []*CollectionPrivateData
+	for _, nsPrivateReadWriteSet := range privateReadWriteSet.GetNsPvtRwset() {
+		for _, collection := range nsPrivateReadWriteSet.GetCollectionPvtRwset() {
+			kvReadWriteSet := &kvrwset.KVRWSet{}
+			if err := proto.Unmarshal(collection.GetRwset(), kvReadWriteSet); err != nil {
+				return nil, fmt.Errorf("failed to deserialize private read-write set for collection %s in namespace %s: %w",
+					collection.GetCollectionName(), nsPrivateReadWriteSet.GetNamespace(), err)
+			}
+
+			results = append(results, &CollectionPrivateData{
+				Namespace:      nsPrivateReadWriteSet.GetNamespace(),
+				CollectionName: collection.GetCollectionName(),
+				ReadWriteSet:   kvReadWriteSet,
+			})
+		}
+	}
+
+	return results, nil
+}
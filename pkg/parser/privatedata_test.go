@@ -0,0 +1,117 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlockAndPrivateData(t *testing.T) {
+	newEnvelope := func(t *testing.T, transactionID string) *common.Envelope {
+		channelHeader := &common.ChannelHeader{
+			Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+			ChannelId: "CHANNEL",
+			TxId:      transactionID,
+		}
+		return NewTestEnvelope(t, channelHeader, &msp.SerializedIdentity{}, &testAction{
+			chaincodeName: "CHAINCODE",
+		})
+	}
+
+	newPrivateReadWriteSet := func(t *testing.T, collectionName string, kvReadWriteSet *kvrwset.KVRWSet) *rwset.TxPvtReadWriteSet {
+		return &rwset.TxPvtReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsPvtRwset: []*rwset.NsPvtReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					CollectionPvtRwset: []*rwset.CollectionPvtReadWriteSet{
+						{
+							CollectionName: collectionName,
+							Rwset:          AssertMarshal(t, kvReadWriteSet),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	validationCodes := []peer.TxValidationCode{peer.TxValidationCode_VALID, peer.TxValidationCode_VALID}
+
+	t.Run("Joins private data with transactions", func(t *testing.T) {
+		expected := &kvrwset.KVRWSet{
+			Writes: []*kvrwset.KVWrite{
+				{
+					Key:   "PRIVATE_KEY",
+					Value: []byte("PRIVATE_VALUE"),
+				},
+			},
+		}
+		blockAndPrivateData := &peer.BlockAndPrivateData{
+			Block: NewTestBlock(t, 1, validationCodes, newEnvelope(t, "TRANSACTION_1"), newEnvelope(t, "TRANSACTION_2")),
+			PrivateDataMap: map[uint64]*rwset.TxPvtReadWriteSet{
+				1: newPrivateReadWriteSet(t, "COLLECTION", expected),
+			},
+		}
+
+		actual, err := ParseBlockAndPrivateData(blockAndPrivateData)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Transactions, 2)
+		require.Empty(t, actual.Transactions[0].PrivateData, "first transaction")
+
+		privateData := actual.Transactions[1].PrivateData
+		require.Len(t, privateData, 1, "second transaction")
+		require.Equal(t, "CHAINCODE", privateData[0].Namespace)
+		require.Equal(t, "COLLECTION", privateData[0].CollectionName)
+		AssertProtoEqual(t, expected, privateData[0].ReadWriteSet)
+	})
+
+	t.Run("Records error for private data with no matching transaction", func(t *testing.T) {
+		blockAndPrivateData := &peer.BlockAndPrivateData{
+			Block: NewTestBlock(t, 1, validationCodes[:1], newEnvelope(t, "TRANSACTION_1")),
+			PrivateDataMap: map[uint64]*rwset.TxPvtReadWriteSet{
+				0: newPrivateReadWriteSet(t, "COLLECTION", &kvrwset.KVRWSet{}),
+				1: newPrivateReadWriteSet(t, "COLLECTION", &kvrwset.KVRWSet{}),
+			},
+		}
+
+		actual, err := ParseBlockAndPrivateData(blockAndPrivateData)
+		require.NoError(t, err)
+
+		require.Len(t, actual.ParseErrors, 1)
+		require.ErrorContains(t, actual.ParseErrors[0], "transaction 1")
+		require.NoError(t, actual.Transactions[0].ParseError)
+		require.Len(t, actual.Transactions[0].PrivateData, 1)
+	})
+
+	t.Run("Records error for invalid collection read-write set on matching transaction", func(t *testing.T) {
+		privateReadWriteSet := newPrivateReadWriteSet(t, "COLLECTION", &kvrwset.KVRWSet{})
+		privateReadWriteSet.GetNsPvtRwset()[0].GetCollectionPvtRwset()[0].Rwset = []byte("NOT_A_READ_WRITE_SET")
+		blockAndPrivateData := &peer.BlockAndPrivateData{
+			Block: NewTestBlock(t, 1, validationCodes, newEnvelope(t, "TRANSACTION_1"), newEnvelope(t, "TRANSACTION_2")),
+			PrivateDataMap: map[uint64]*rwset.TxPvtReadWriteSet{
+				0: privateReadWriteSet,
+				1: newPrivateReadWriteSet(t, "COLLECTION", &kvrwset.KVRWSet{}),
+			},
+		}
+
+		actual, err := ParseBlockAndPrivateData(blockAndPrivateData)
+		require.NoError(t, err)
+
+		require.ErrorContains(t, actual.Transactions[0].ParseError, "COLLECTION")
+		require.Empty(t, actual.Transactions[0].PrivateData)
+		require.Equal(t, "TRANSACTION_1", actual.Transactions[0].TransactionID())
+
+		require.NoError(t, actual.Transactions[1].ParseError)
+		require.Len(t, actual.Transactions[1].PrivateData, 1)
+		require.Empty(t, actual.ParseErrors)
+	})
+}
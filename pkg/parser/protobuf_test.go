@@ -0,0 +1,134 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func AssertProtoEqual(t *testing.T, expected protoreflect.ProtoMessage, actual protoreflect.ProtoMessage) {
+	if diff := cmp.Diff(expected, actual, protocmp.Transform()); diff != "" {
+		require.FailNow(t, "Not equal", "Diff:\n- Expected\n+ Actual\n\n%s", diff)
+	}
+}
+
+func AssertMarshal(t *testing.T, message protoreflect.ProtoMessage, msgAndArgs ...any) []byte {
+	bytes, err := proto.Marshal(message)
+	require.NoError(t, err, msgAndArgs...)
+	return bytes
+}
+
+type testAction struct {
+	chaincodeName string
+	arguments     [][]byte
+	response      *peer.Response
+	readWriteSet  *rwset.TxReadWriteSet
+	event         *peer.ChaincodeEvent
+}
+
+func NewTestEnvelope(t *testing.T, channelHeader *common.ChannelHeader, creator *msp.SerializedIdentity, actions ...*testAction) *common.Envelope {
+	transaction := &peer.Transaction{}
+	for _, action := range actions {
+		transaction.Actions = append(transaction.Actions, NewTestTransactionAction(t, action))
+	}
+
+	payload := &common.Payload{
+		Header: &common.Header{
+			ChannelHeader: AssertMarshal(t, channelHeader, "ChannelHeader"),
+			SignatureHeader: AssertMarshal(t, &common.SignatureHeader{
+				Creator: AssertMarshal(t, creator, "Creator"),
+			}, "SignatureHeader"),
+		},
+		Data: AssertMarshal(t, transaction, "Transaction"),
+	}
+
+	return &common.Envelope{
+		Payload: AssertMarshal(t, payload, "Payload"),
+	}
+}
+
+func NewTestTransactionAction(t *testing.T, action *testAction) *peer.TransactionAction {
+	chaincodeID := &peer.ChaincodeID{
+		Name: action.chaincodeName,
+	}
+
+	invocationSpec := &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: chaincodeID,
+			Input: &peer.ChaincodeInput{
+				Args: action.arguments,
+			},
+		},
+	}
+
+	chaincodeAction := &peer.ChaincodeAction{
+		ChaincodeId: chaincodeID,
+		Response:    action.response,
+	}
+	if action.readWriteSet != nil {
+		chaincodeAction.Results = AssertMarshal(t, action.readWriteSet, "TxReadWriteSet")
+	}
+	if action.event != nil {
+		chaincodeAction.Events = AssertMarshal(t, action.event, "ChaincodeEvent")
+	}
+
+	actionPayload := &peer.ChaincodeActionPayload{
+		ChaincodeProposalPayload: AssertMarshal(t, &peer.ChaincodeProposalPayload{
+			Input: AssertMarshal(t, invocationSpec, "ChaincodeInvocationSpec"),
+		}, "ChaincodeProposalPayload"),
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: AssertMarshal(t, &peer.ProposalResponsePayload{
+				ProposalHash: []byte("PROPOSAL_HASH"),
+				Extension:    AssertMarshal(t, chaincodeAction, "ChaincodeAction"),
+			}, "ProposalResponsePayload"),
+			Endorsements: []*peer.Endorsement{
+				{
+					Endorser:  []byte("ENDORSER"),
+					Signature: []byte("SIGNATURE"),
+				},
+			},
+		},
+	}
+
+	return &peer.TransactionAction{
+		Payload: AssertMarshal(t, actionPayload, "ChaincodeActionPayload"),
+	}
+}
+
+func NewTestBlock(t *testing.T, blockNumber uint64, validationCodes []peer.TxValidationCode, envelopes ...*common.Envelope) *common.Block {
+	data := make([][]byte, 0, len(envelopes))
+	for _, envelope := range envelopes {
+		data = append(data, AssertMarshal(t, envelope, "Envelope"))
+	}
+
+	transactionsFilter := make([]byte, 0, len(validationCodes))
+	for _, code := range validationCodes {
+		transactionsFilter = append(transactionsFilter, byte(code))
+	}
+
+	metadata := make([][]byte, len(common.BlockMetadataIndex_name))
+	metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = transactionsFilter
+
+	return &common.Block{
+		Header: &common.BlockHeader{
+			Number: blockNumber,
+		},
+		Data: &common.BlockData{
+			Data: data,
+		},
+		Metadata: &common.BlockMetadata{
+			Metadata: metadata,
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"google.golang.org/protobuf/proto"
+)
+
+// NamespaceReadWriteSet describes the ledger reads and writes made within a single namespace, which is typically a
+// chaincode.
+type NamespaceReadWriteSet struct {
+	// Namespace in which the reads and writes were made.
+	Namespace string
+	// ReadWriteSet containing the key reads, range query information, key writes and key metadata writes for the
+	// namespace public data.
+	ReadWriteSet *kvrwset.KVRWSet
+	// CollectionHashedReadWriteSets describe the private data collection reads and writes made within the namespace.
+	// Only hashes of the private data keys and values are included.
+	CollectionHashedReadWriteSets []*CollectionHashedReadWriteSet
+}
+
+// CollectionHashedReadWriteSet describes the reads and writes made within a private data collection, using hashes of
+// the keys and values.
+type CollectionHashedReadWriteSet struct {
+	// CollectionName of the private data collection.
+	CollectionName string
+	// HashedReadWriteSet containing the hashed key reads, writes and metadata writes for the collection.
+	HashedReadWriteSet *kvrwset.HashedRWSet
+	// PrivateReadWriteSetHash is the hash of the collection private read-write set.
+	PrivateReadWriteSetHash []byte
+}
+
+// ParseReadWriteSet decodes the namespace read-write sets contained in a transaction read-write set.
+func ParseReadWriteSet(readWriteSet *rwset.TxReadWriteSet) ([]*NamespaceReadWriteSet, error) {
+	if readWriteSet.GetDataModel() != rwset.TxReadWriteSet_KV {
+		return nil, fmt.Errorf("unsupported read-write set data model: %v", readWriteSet.GetDataModel())
+	}
+
+	results := make([]*NamespaceReadWriteSet, 0, len(readWriteSet.GetNsRwset()))
+	for _, nsReadWriteSet := range readWriteSet.GetNsRwset() {
+		result, err := parseNamespaceReadWriteSet(nsReadWriteSet)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func parseNamespaceReadWriteSet(nsReadWriteSet *rwset.NsReadWriteSet) (*NamespaceReadWriteSet, error) {
+	kvReadWriteSet := &kvrwset.KVRWSet{}
+	if err := proto.Unmarshal(nsReadWriteSet.GetRwset(), kvReadWriteSet); err != nil {
+		return nil, fmt.Errorf("failed to deserialize read-write set for namespace %s: %w", nsReadWriteSet.GetNamespace(), err)
+	}
+
+	result := &NamespaceReadWriteSet{
+		Namespace:                     nsReadWriteSet.GetNamespace(),
+		ReadWriteSet:                  kvReadWriteSet,
+		CollectionHashedReadWriteSets: make([]*CollectionHashedReadWriteSet, 0, len(nsReadWriteSet.GetCollectionHashedRwset())),
+	}
+
+	for _, collection := range nsReadWriteSet.GetCollectionHashedRwset() {
+		hashedReadWriteSet := &kvrwset.HashedRWSet{}
+		if err := proto.Unmarshal(collection.GetHashedRwset(), hashedReadWriteSet); err != nil {
+			return nil, fmt.Errorf("failed to deserialize hashed read-write set for collection %s in namespace %s: %w",
+				collection.GetCollectionName(), nsReadWriteSet.GetNamespace(), err)
+		}
+
+		result.CollectionHashedReadWriteSets = append(result.CollectionHashedReadWriteSets, &CollectionHashedReadWriteSet{
+			CollectionName:          collection.GetCollectionName(),
+			HashedReadWriteSet:      hashedReadWriteSet,
+			PrivateReadWriteSetHash: collection.GetPvtRwsetHash(),
+		})
+	}
+
+	return result, nil
+}
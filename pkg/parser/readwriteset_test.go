@@ -0,0 +1,150 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReadWriteSet(t *testing.T) {
+	t.Run("Decodes public reads, range queries, writes and metadata writes", func(t *testing.T) {
+		expected := &kvrwset.KVRWSet{
+			Reads: []*kvrwset.KVRead{
+				{
+					Key: "READ_KEY",
+					Version: &kvrwset.Version{
+						BlockNum: 1,
+						TxNum:    2,
+					},
+				},
+			},
+			RangeQueriesInfo: []*kvrwset.RangeQueryInfo{
+				{
+					StartKey:     "START_KEY",
+					EndKey:       "END_KEY",
+					ItrExhausted: true,
+				},
+			},
+			Writes: []*kvrwset.KVWrite{
+				{
+					Key:   "WRITE_KEY",
+					Value: []byte("VALUE"),
+				},
+				{
+					Key:      "DELETE_KEY",
+					IsDelete: true,
+				},
+			},
+			MetadataWrites: []*kvrwset.KVMetadataWrite{
+				{
+					Key: "METADATA_KEY",
+					Entries: []*kvrwset.KVMetadataEntry{
+						{
+							Name:  "VALIDATION_PARAMETER",
+							Value: []byte("POLICY"),
+						},
+					},
+				},
+			},
+		}
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					Rwset:     AssertMarshal(t, expected),
+				},
+			},
+		}
+
+		actual, err := ParseReadWriteSet(readWriteSet)
+		require.NoError(t, err)
+
+		require.Len(t, actual, 1)
+		require.Equal(t, "CHAINCODE", actual[0].Namespace)
+		AssertProtoEqual(t, expected, actual[0].ReadWriteSet)
+		require.Empty(t, actual[0].CollectionHashedReadWriteSets)
+	})
+
+	t.Run("Decodes collection hashed read-write sets", func(t *testing.T) {
+		expected := &kvrwset.HashedRWSet{
+			HashedReads: []*kvrwset.KVReadHash{
+				{
+					KeyHash: []byte("READ_KEY_HASH"),
+				},
+			},
+			HashedWrites: []*kvrwset.KVWriteHash{
+				{
+					KeyHash:   []byte("WRITE_KEY_HASH"),
+					ValueHash: []byte("VALUE_HASH"),
+				},
+			},
+		}
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					CollectionHashedRwset: []*rwset.CollectionHashedReadWriteSet{
+						{
+							CollectionName: "COLLECTION",
+							HashedRwset:    AssertMarshal(t, expected),
+							PvtRwsetHash:   []byte("PRIVATE_HASH"),
+						},
+					},
+				},
+			},
+		}
+
+		actual, err := ParseReadWriteSet(readWriteSet)
+		require.NoError(t, err)
+
+		collections := actual[0].CollectionHashedReadWriteSets
+		require.Len(t, collections, 1)
+		require.Equal(t, "COLLECTION", collections[0].CollectionName)
+		AssertProtoEqual(t, expected, collections[0].HashedReadWriteSet)
+		require.Equal(t, []byte("PRIVATE_HASH"), collections[0].PrivateReadWriteSetHash)
+	})
+
+	t.Run("Returns error for invalid namespace read-write set", func(t *testing.T) {
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					Rwset:     []byte("NOT_A_READ_WRITE_SET"),
+				},
+			},
+		}
+
+		_, err := ParseReadWriteSet(readWriteSet)
+
+		require.ErrorContains(t, err, "CHAINCODE")
+	})
+
+	t.Run("Returns error for invalid collection hashed read-write set", func(t *testing.T) {
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					CollectionHashedRwset: []*rwset.CollectionHashedReadWriteSet{
+						{
+							CollectionName: "COLLECTION",
+							HashedRwset:    []byte("NOT_A_HASHED_READ_WRITE_SET"),
+						},
+					},
+				},
+			},
+		}
+
+		_, err := ParseReadWriteSet(readWriteSet)
+
+		require.ErrorContains(t, err, "COLLECTION")
+	})
+}
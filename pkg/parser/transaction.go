@@ -0,0 +1,258 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Transaction is the parsed content of a transaction envelope.
+type Transaction struct {
+	// ChannelHeader of the transaction, which includes the transaction type, ID and channel name.
+	ChannelHeader *common.ChannelHeader
+	// Creator is the identity of the client that created the transaction.
+	Creator *msp.SerializedIdentity
+	// ValidationCode assigned to the transaction when the block was committed. Transactions that have not been
+	// committed in a block have a validation code of NOT_VALIDATED.
+	ValidationCode peer.TxValidationCode
+	// Actions performed by an endorser transaction. Other transaction types, such as configuration transactions, have
+	// no actions.
+	Actions []*TransactionAction
+	// PrivateData read and written by the transaction. This is available only for transactions parsed from block and
+	// private data events, using [ParseBlockAndPrivateData], and only for collections that the Gateway peer is
+	// authorized to access.
+	PrivateData []*CollectionPrivateData
+	// ParseError is the error encountered parsing a malformed transaction or its private data from a block, or nil if
+	// the transaction was parsed successfully. Only the validation code, and the envelope if it could be deserialized,
+	// are available for a transaction envelope that failed to parse.
+	ParseError error
+
+	envelope *common.Envelope
+}
+
+// TransactionID of the transaction.
+func (tx *Transaction) TransactionID() string {
+	return tx.ChannelHeader.GetTxId()
+}
+
+// IsValid returns true if the transaction was successfully validated when committed.
+func (tx *Transaction) IsValid() bool {
+	return tx.ValidationCode == peer.TxValidationCode_VALID
+}
+
+// NamespaceReadWriteSets returns the namespace read-write sets for all actions performed by the transaction.
+func (tx *Transaction) NamespaceReadWriteSets() []*NamespaceReadWriteSet {
+	var results []*NamespaceReadWriteSet
+	for _, action := range tx.Actions {
+		results = append(results, action.NamespaceReadWriteSets...)
+	}
+
+	return results
+}
+
+// Proto returns the protobuf message from which the transaction was parsed.
+func (tx *Transaction) Proto() *common.Envelope {
+	return tx.envelope
+}
+
+// TransactionAction is a chaincode invocation performed by an endorser transaction.
+type TransactionAction struct {
+	// ChaincodeName of the invoked chaincode.
+	ChaincodeName string
+	// Arguments supplied to the chaincode, the first of which is typically the transaction function name.
+	Arguments [][]byte
+	// Response returned by the chaincode.
+	Response *peer.Response
+	// ReadWriteSet describing the ledger reads and writes made by the chaincode.
+	ReadWriteSet *rwset.TxReadWriteSet
+	// NamespaceReadWriteSets are the decoded content of the read-write set, for each namespace in which reads or
+	// writes were made.
+	NamespaceReadWriteSets []*NamespaceReadWriteSet
+	// Event emitted by the chaincode, or nil if no event was emitted.
+	Event *peer.ChaincodeEvent
+	// Endorsements of the chaincode results.
+	Endorsements []*peer.Endorsement
+	// ProposalResponsePayload is the serialized proposal response payload signed by the endorsers. Each endorsement
+	// signature is over this payload concatenated with the serialized endorser identity.
+	ProposalResponsePayload []byte
+	// ProposalHash is the hash of the endorsed transaction proposal, recorded in the proposal response payload.
+	ProposalHash []byte
+}
+
+// ParseEnvelope parses the content of a transaction envelope. Since the envelope does not include the validation code
+// assigned on commit, the validation code of the returned transaction is NOT_VALIDATED.
+func ParseEnvelope(envelope *common.Envelope) (*Transaction, error) {
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.GetPayload(), payload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize payload: %w", err)
+	}
+
+	channelHeader := &common.ChannelHeader{}
+	if err := proto.Unmarshal(payload.GetHeader().GetChannelHeader(), channelHeader); err != nil {
+		return nil, fmt.Errorf("failed to deserialize channel header: %w", err)
+	}
+
+	creator, err := parseCreator(payload.GetHeader())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Transaction{
+		ChannelHeader:  channelHeader,
+		Creator:        creator,
+		ValidationCode: peer.TxValidationCode_NOT_VALIDATED,
+		envelope:       envelope,
+	}
+
+	if common.HeaderType(channelHeader.GetType()) == common.HeaderType_ENDORSER_TRANSACTION {
+		if result.Actions, err = parseTransactionActions(payload.GetData()); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func parseCreator(header *common.Header) (*msp.SerializedIdentity, error) {
+	signatureHeader := &common.SignatureHeader{}
+	if err := proto.Unmarshal(header.GetSignatureHeader(), signatureHeader); err != nil {
+		return nil, fmt.Errorf("failed to deserialize signature header: %w", err)
+	}
+
+	creator := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(signatureHeader.GetCreator(), creator); err != nil {
+		return nil, fmt.Errorf("failed to deserialize creator identity: %w", err)
+	}
+
+	return creator, nil
+}
+
+func parseTransactionActions(data []byte) ([]*TransactionAction, error) {
+	transaction := &peer.Transaction{}
+	if err := proto.Unmarshal(data, transaction); err != nil {
+		return nil, fmt.Errorf("failed to deserialize transaction: %w", err)
+	}
+
+	results := make([]*TransactionAction, 0, len(transaction.GetActions()))
+	for _, transactionAction := range transaction.GetActions() {
+		action, err := parseTransactionAction(transactionAction)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, action)
+	}
+
+	return results, nil
+}
+
+func parseTransactionAction(transactionAction *peer.TransactionAction) (*TransactionAction, error) {
+	actionPayload := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(transactionAction.GetPayload(), actionPayload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize chaincode action payload: %w", err)
+	}
+
+	invocationSpec, err := parseInvocationSpec(actionPayload.GetChaincodeProposalPayload())
+	if err != nil {
+		return nil, err
+	}
+
+	proposalResponsePayload := actionPayload.GetAction().GetProposalResponsePayload()
+	responsePayload, chaincodeAction, err := parseProposalResponsePayload(proposalResponsePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	readWriteSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(chaincodeAction.GetResults(), readWriteSet); err != nil {
+		return nil, fmt.Errorf("failed to deserialize read-write set: %w", err)
+	}
+
+	namespaceReadWriteSets, err := parseActionReadWriteSet(readWriteSet)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := parseChaincodeEvent(chaincodeAction.GetEvents())
+	if err != nil {
+		return nil, err
+	}
+
+	chaincodeName := chaincodeAction.GetChaincodeId().GetName()
+	if chaincodeName == "" {
+		chaincodeName = invocationSpec.GetChaincodeSpec().GetChaincodeId().GetName()
+	}
+
+	result := &TransactionAction{
+		ChaincodeName:           chaincodeName,
+		Arguments:               invocationSpec.GetChaincodeSpec().GetInput().GetArgs(),
+		Response:                chaincodeAction.GetResponse(),
+		ReadWriteSet:            readWriteSet,
+		NamespaceReadWriteSets:  namespaceReadWriteSets,
+		Event:                   event,
+		Endorsements:            actionPayload.GetAction().GetEndorsements(),
+		ProposalResponsePayload: proposalResponsePayload,
+		ProposalHash:            responsePayload.GetProposalHash(),
+	}
+	return result, nil
+}
+
+func parseActionReadWriteSet(readWriteSet *rwset.TxReadWriteSet) ([]*NamespaceReadWriteSet, error) {
+	if len(readWriteSet.GetNsRwset()) == 0 {
+		return nil, nil
+	}
+
+	return ParseReadWriteSet(readWriteSet)
+}
+
+func parseInvocationSpec(chaincodeProposalPayload []byte) (*peer.ChaincodeInvocationSpec, error) {
+	proposalPayload := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(chaincodeProposalPayload, proposalPayload); err != nil {
+		return nil, fmt.Errorf("failed to deserialize chaincode proposal payload: %w", err)
+	}
+
+	invocationSpec := &peer.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(proposalPayload.GetInput(), invocationSpec); err != nil {
+		return nil, fmt.Errorf("failed to deserialize chaincode invocation spec: %w", err)
+	}
+
+	return invocationSpec, nil
+}
+
+func parseProposalResponsePayload(proposalResponsePayload []byte) (*peer.ProposalResponsePayload, *peer.ChaincodeAction, error) {
+	responsePayload := &peer.ProposalResponsePayload{}
+	if err := proto.Unmarshal(proposalResponsePayload, responsePayload); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize proposal response payload: %w", err)
+	}
+
+	chaincodeAction := &peer.ChaincodeAction{}
+	if err := proto.Unmarshal(responsePayload.GetExtension(), chaincodeAction); err != nil {
+		return nil, nil, fmt.Errorf("failed to deserialize chaincode action: %w", err)
+	}
+
+	return responsePayload, chaincodeAction, nil
+}
+
+func parseChaincodeEvent(eventBytes []byte) (*peer.ChaincodeEvent, error) {
+	if len(eventBytes) == 0 {
+		return nil, nil
+	}
+
+	event := &peer.ChaincodeEvent{}
+	if err := proto.Unmarshal(eventBytes, event); err != nil {
+		return nil, fmt.Errorf("failed to deserialize chaincode event: %w", err)
+	}
+
+	if event.GetEventName() == "" {
+		return nil, nil
+	}
+
+	return event, nil
+}
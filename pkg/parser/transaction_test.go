@@ -0,0 +1,152 @@
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go-apiv2/common"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go-apiv2/msp"
+	"github.com/hyperledger/fabric-protos-go-apiv2/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvelope(t *testing.T) {
+	channelHeader := &common.ChannelHeader{
+		Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+		ChannelId: "CHANNEL",
+		TxId:      "TRANSACTION_ID",
+	}
+	creator := &msp.SerializedIdentity{
+		Mspid:   "MSP_ID",
+		IdBytes: []byte("CERTIFICATE"),
+	}
+
+	t.Run("Parses endorser transaction", func(t *testing.T) {
+		kvReadWriteSet := &kvrwset.KVRWSet{
+			Writes: []*kvrwset.KVWrite{
+				{
+					Key:   "KEY",
+					Value: []byte("VALUE"),
+				},
+			},
+		}
+		readWriteSet := &rwset.TxReadWriteSet{
+			DataModel: rwset.TxReadWriteSet_KV,
+			NsRwset: []*rwset.NsReadWriteSet{
+				{
+					Namespace: "CHAINCODE",
+					Rwset:     AssertMarshal(t, kvReadWriteSet),
+				},
+			},
+		}
+		event := &peer.ChaincodeEvent{
+			ChaincodeId: "CHAINCODE",
+			TxId:        "TRANSACTION_ID",
+			EventName:   "EVENT",
+			Payload:     []byte("EVENT_PAYLOAD"),
+		}
+		response := &peer.Response{
+			Status:  200,
+			Payload: []byte("RESULT"),
+		}
+		envelope := NewTestEnvelope(t, channelHeader, creator, &testAction{
+			chaincodeName: "CHAINCODE",
+			arguments:     [][]byte{[]byte("FUNCTION"), []byte("ARG")},
+			response:      response,
+			readWriteSet:  readWriteSet,
+			event:         event,
+		})
+
+		actual, err := ParseEnvelope(envelope)
+		require.NoError(t, err)
+
+		AssertProtoEqual(t, channelHeader, actual.ChannelHeader)
+		AssertProtoEqual(t, creator, actual.Creator)
+		require.Equal(t, "TRANSACTION_ID", actual.TransactionID(), "TransactionID")
+		require.Equal(t, peer.TxValidationCode_NOT_VALIDATED, actual.ValidationCode, "ValidationCode")
+		require.False(t, actual.IsValid(), "IsValid")
+		require.Same(t, envelope, actual.Proto(), "Proto")
+
+		require.Len(t, actual.Actions, 1, "Actions")
+		action := actual.Actions[0]
+		require.Equal(t, "CHAINCODE", action.ChaincodeName, "ChaincodeName")
+		require.Equal(t, [][]byte{[]byte("FUNCTION"), []byte("ARG")}, action.Arguments, "Arguments")
+		AssertProtoEqual(t, response, action.Response)
+		AssertProtoEqual(t, readWriteSet, action.ReadWriteSet)
+		require.Len(t, action.NamespaceReadWriteSets, 1, "NamespaceReadWriteSets")
+		require.Equal(t, "CHAINCODE", action.NamespaceReadWriteSets[0].Namespace, "Namespace")
+		AssertProtoEqual(t, kvReadWriteSet, action.NamespaceReadWriteSets[0].ReadWriteSet)
+		require.Equal(t, action.NamespaceReadWriteSets, actual.NamespaceReadWriteSets(), "Transaction NamespaceReadWriteSets")
+		AssertProtoEqual(t, event, action.Event)
+		require.Len(t, action.Endorsements, 1, "Endorsements")
+		require.Equal(t, []byte("PROPOSAL_HASH"), action.ProposalHash, "ProposalHash")
+		require.NotEmpty(t, action.ProposalResponsePayload, "ProposalResponsePayload")
+	})
+
+	t.Run("Event is nil if no chaincode event emitted", func(t *testing.T) {
+		envelope := NewTestEnvelope(t, channelHeader, creator, &testAction{
+			chaincodeName: "CHAINCODE",
+		})
+
+		actual, err := ParseEnvelope(envelope)
+		require.NoError(t, err)
+
+		require.Nil(t, actual.Actions[0].Event)
+	})
+
+	t.Run("Configuration transaction has no actions", func(t *testing.T) {
+		configChannelHeader := &common.ChannelHeader{
+			Type:      int32(common.HeaderType_CONFIG),
+			ChannelId: "CHANNEL",
+		}
+		envelope := &common.Envelope{
+			Payload: AssertMarshal(t, &common.Payload{
+				Header: &common.Header{
+					ChannelHeader: AssertMarshal(t, configChannelHeader),
+					SignatureHeader: AssertMarshal(t, &common.SignatureHeader{
+						Creator: AssertMarshal(t, creator),
+					}),
+				},
+				Data: []byte("CONFIG_ENVELOPE"),
+			}),
+		}
+
+		actual, err := ParseEnvelope(envelope)
+		require.NoError(t, err)
+
+		AssertProtoEqual(t, configChannelHeader, actual.ChannelHeader)
+		require.Empty(t, actual.Actions)
+	})
+
+	t.Run("Returns error for invalid payload", func(t *testing.T) {
+		envelope := &common.Envelope{
+			Payload: []byte("NOT_A_PAYLOAD"),
+		}
+
+		_, err := ParseEnvelope(envelope)
+
+		require.ErrorContains(t, err, "payload")
+	})
+
+	t.Run("Returns error for invalid transaction data", func(t *testing.T) {
+		envelope := &common.Envelope{
+			Payload: AssertMarshal(t, &common.Payload{
+				Header: &common.Header{
+					ChannelHeader: AssertMarshal(t, channelHeader),
+					SignatureHeader: AssertMarshal(t, &common.SignatureHeader{
+						Creator: AssertMarshal(t, creator),
+					}),
+				},
+				Data: []byte("NOT_A_TRANSACTION"),
+			}),
+		}
+
+		_, err := ParseEnvelope(envelope)
+
+		require.ErrorContains(t, err, "transaction")
+	})
+}
@@ -53,10 +53,10 @@ func (listener *BlockAndPrivateDataEventListener) Close() {
 
 type CheckpointBlockAndPrivateDataEventListener struct {
 	listener   BlockAndPrivateDataEvents
-	checkpoint func(*peer.BlockAndPrivateData)
+	checkpoint func(*peer.BlockAndPrivateData) error
 }
 
-func NewCheckpointBlockAndPrivateDataEventListener(listener BlockAndPrivateDataEvents, checkpoint func(*peer.BlockAndPrivateData)) *CheckpointBlockAndPrivateDataEventListener {
+func NewCheckpointBlockAndPrivateDataEventListener(listener BlockAndPrivateDataEvents, checkpoint func(*peer.BlockAndPrivateData) error) *CheckpointBlockAndPrivateDataEventListener {
 	checkpointListener := &CheckpointBlockAndPrivateDataEventListener{
 		listener:   listener,
 		checkpoint: checkpoint,
@@ -70,7 +70,9 @@ func (listener *CheckpointBlockAndPrivateDataEventListener) Event() (*peer.Block
 		return nil, err
 	}
 
-	listener.checkpoint(event)
+	if err := listener.checkpoint(event); err != nil {
+		return nil, err
+	}
 
 	return event, nil
 }
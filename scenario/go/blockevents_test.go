@@ -53,10 +53,10 @@ func (listener *BlockEventListener) Close() {
 
 type CheckpointBlockEventListener struct {
 	listener   BlockEvents
-	checkpoint func(*common.Block)
+	checkpoint func(*common.Block) error
 }
 
-func NewCheckpointBlockEventListener(listener BlockEvents, checkpoint func(*common.Block)) *CheckpointBlockEventListener {
+func NewCheckpointBlockEventListener(listener BlockEvents, checkpoint func(*common.Block) error) *CheckpointBlockEventListener {
 	checkpointListener := &CheckpointBlockEventListener{
 		listener:   listener,
 		checkpoint: checkpoint,
@@ -70,7 +70,9 @@ func (listener *CheckpointBlockEventListener) Event() (*common.Block, error) {
 		return nil, err
 	}
 
-	listener.checkpoint(event)
+	if err := listener.checkpoint(event); err != nil {
+		return nil, err
+	}
 
 	return event, nil
 }
@@ -52,10 +52,10 @@ func (listener *ChaincodeEventListener) Close() {
 
 type CheckpointChaincodeEventListener struct {
 	listener   ChaincodeEvents
-	checkpoint func(*client.ChaincodeEvent)
+	checkpoint func(*client.ChaincodeEvent) error
 }
 
-func NewCheckpointChaincodeEventListener(listener ChaincodeEvents, checkpoint func(*client.ChaincodeEvent)) *CheckpointChaincodeEventListener {
+func NewCheckpointChaincodeEventListener(listener ChaincodeEvents, checkpoint func(*client.ChaincodeEvent) error) *CheckpointChaincodeEventListener {
 	checkpointListener := &CheckpointChaincodeEventListener{
 		listener:   listener,
 		checkpoint: checkpoint,
@@ -69,7 +69,9 @@ func (listener *CheckpointChaincodeEventListener) Event() (*client.ChaincodeEven
 		return nil, err
 	}
 
-	listener.checkpoint(event)
+	if err := listener.checkpoint(event); err != nil {
+		return nil, err
+	}
 
 	return event, nil
 }
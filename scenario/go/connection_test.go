@@ -221,7 +221,7 @@ type GatewayConnection struct {
 	contract                          *client.Contract
 	ctx                               context.Context
 	cancel                            context.CancelFunc
-	checkpointer                      *client.InMemoryCheckpointer
+	checkpointer                      client.Checkpointer
 	chaincodeEventListeners           map[string]ChaincodeEvents
 	blockEventListeners               map[string]BlockEvents
 	filteredBlockEventListeners       map[string]FilteredBlockEvents
@@ -301,8 +301,8 @@ func (connection *GatewayConnection) ListenForChaincodeEventsUsingCheckpointer(l
 		return err
 	}
 
-	checkpointListener := NewCheckpointChaincodeEventListener(listener, func(event *client.ChaincodeEvent) {
-		connection.checkpointer.CheckpointChaincodeEvent(event)
+	checkpointListener := NewCheckpointChaincodeEventListener(listener, func(event *client.ChaincodeEvent) error {
+		return connection.checkpointer.CheckpointChaincodeEvent(event)
 	})
 	connection.setChaincodeEventListener(listenerName, checkpointListener)
 	return nil
@@ -369,8 +369,8 @@ func (connection *GatewayConnection) ListenForBlockEventsUsingCheckpointer(liste
 		return err
 	}
 
-	checkpointListener := NewCheckpointBlockEventListener(listener, func(event *common.Block) {
-		connection.checkpointer.CheckpointBlock(event.GetHeader().GetNumber())
+	checkpointListener := NewCheckpointBlockEventListener(listener, func(event *common.Block) error {
+		return connection.checkpointer.CheckpointBlock(event.GetHeader().GetNumber())
 	})
 	connection.setBlockEventListener(listenerName, checkpointListener)
 	return nil
@@ -386,8 +386,8 @@ func (connection *GatewayConnection) ListenForFilteredBlockEventsUsingCheckpoint
 		return err
 	}
 
-	checkpointListener := NewCheckpointFilteredBlockEventListener(listener, func(event *peer.FilteredBlock) {
-		connection.checkpointer.CheckpointBlock(event.GetNumber())
+	checkpointListener := NewCheckpointFilteredBlockEventListener(listener, func(event *peer.FilteredBlock) error {
+		return connection.checkpointer.CheckpointBlock(event.GetNumber())
 	})
 	connection.setFilteredBlockEventListener(listenerName, checkpointListener)
 	return nil
@@ -403,8 +403,8 @@ func (connection *GatewayConnection) ListenForBlockAndPrivateDataEventsUsingChec
 		return err
 	}
 
-	checkpointListener := NewCheckpointBlockAndPrivateDataEventListener(listener, func(event *peer.BlockAndPrivateData) {
-		connection.checkpointer.CheckpointBlock(event.GetBlock().GetHeader().GetNumber())
+	checkpointListener := NewCheckpointBlockAndPrivateDataEventListener(listener, func(event *peer.BlockAndPrivateData) error {
+		return connection.checkpointer.CheckpointBlock(event.GetBlock().GetHeader().GetNumber())
 	})
 	connection.setBlockAndPrivateDataEventListener(listenerName, checkpointListener)
 	return nil
@@ -53,10 +53,10 @@ func (listener *FilteredBlockEventListener) Close() {
 
 type CheckpointFilteredBlockEventListener struct {
 	listener   FilteredBlockEvents
-	checkpoint func(*peer.FilteredBlock)
+	checkpoint func(*peer.FilteredBlock) error
 }
 
-func NewCheckpointFilteredBlockEventListener(listener FilteredBlockEvents, checkpoint func(*peer.FilteredBlock)) *CheckpointFilteredBlockEventListener {
+func NewCheckpointFilteredBlockEventListener(listener FilteredBlockEvents, checkpoint func(*peer.FilteredBlock) error) *CheckpointFilteredBlockEventListener {
 	checkpointListener := &CheckpointFilteredBlockEventListener{
 		listener:   listener,
 		checkpoint: checkpoint,
@@ -70,7 +70,9 @@ func (listener *CheckpointFilteredBlockEventListener) Event() (*peer.FilteredBlo
 		return nil, err
 	}
 
-	listener.checkpoint(event)
+	if err := listener.checkpoint(event); err != nil {
+		return nil, err
+	}
 
 	return event, nil
 }